@@ -0,0 +1,255 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"zgo.at/uni/v2/unidata"
+)
+
+var reIdent = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// codegen writes source code for lang with a lookup table for all codepoints
+// in the print query in args.
+func codegen(out io.Writer, args []string, lang, name string) error {
+	if len(args) == 0 {
+		return errors.New("codegen: need a query")
+	}
+	lang, err := match(lang, "go", "c", "rust", "python")
+	if err != nil {
+		return fmt.Errorf("-lang flag: %w", err)
+	}
+	if !reIdent.MatchString(name) {
+		return fmt.Errorf("-name flag: not a valid identifier: %q", name)
+	}
+
+	var cps []rune
+	err = findCodepoints(io.Discard, args, printAsListCompact, func(info unidata.Codepoint) {
+		cps = append(cps, info.Codepoint)
+	})
+	if err != nil {
+		return err
+	}
+	if len(cps) == 0 {
+		return errNoMatches
+	}
+	slices.Sort(cps)
+	cps = slices.Compact(cps)
+	ranges := toRanges(cps)
+
+	cmdline := fmt.Sprintf("uni codegen -lang %s -name %s %s", lang, name, quoteArgs(args))
+	header := fmt.Sprintf("Code generated by %q; DO NOT EDIT.\n\nUnicode %s; %d codepoints in %d ranges.",
+		cmdline, unidata.Unicodes[unidata.UnicodeLatest].Name, len(cps), len(ranges))
+
+	switch lang {
+	case "go":
+		return codegenGo(out, header, name, cps)
+	case "c":
+		codegenC(out, header, name, ranges)
+	case "rust":
+		codegenRust(out, header, name, ranges)
+	case "python":
+		codegenPython(out, header, name, cps, ranges)
+	}
+	return nil
+}
+
+// toRanges converts a sorted list of codepoints to ranges of consecutive
+// codepoints.
+func toRanges(cps []rune) [][2]rune {
+	var ranges [][2]rune
+	for i := 0; i < len(cps); i++ {
+		start := cps[i]
+		for i+1 < len(cps) && cps[i+1] == cps[i]+1 {
+			i++
+		}
+		ranges = append(ranges, [2]rune{start, cps[i]})
+	}
+	return ranges
+}
+
+// quoteArgs quotes arguments with spaces or shell characters in single quotes.
+func quoteArgs(args []string) string {
+	q := make([]string, 0, len(args))
+	for _, a := range args {
+		if strings.ContainsAny(a, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+			a = "'" + strings.ReplaceAll(a, "'", `'\''`) + "'"
+		}
+		q = append(q, a)
+	}
+	return strings.Join(q, " ")
+}
+
+// snakeCase converts "isAllowed" to "is_allowed"; names that are already
+// snake_case are returned as-is.
+func snakeCase(name string) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range name {
+		if unicode.IsUpper(r) && prev != 0 && prev != '_' && !unicode.IsUpper(prev) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+		prev = r
+	}
+	return b.String()
+}
+
+// comment prefixes every line in text with a comment marker.
+func comment(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(prefix+" "+lines[i], " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codegenGo writes a *unicode.RangeTable, using strides where possible like
+// the tables in the unicode package.
+func codegenGo(out io.Writer, header, name string, cps []rune) error {
+	var r16, r32 []string
+	latinOffset := 0
+	for i := 0; i < len(cps); {
+		lo, stride, n := cps[i], rune(1), 1
+		if i+1 < len(cps) && (cps[i] > 0xffff) == (cps[i+1] > 0xffff) {
+			stride = cps[i+1] - cps[i]
+			for i+n < len(cps) && cps[i+n]-cps[i+n-1] == stride && (cps[i+n] > 0xffff) == (lo > 0xffff) {
+				n++
+			}
+			// Don't use a stride for just two codepoints; the next may be
+			// the start of a longer run.
+			if n == 2 && stride > 1 {
+				stride, n = 1, 1
+			}
+		}
+		hi := cps[i+n-1]
+		i += n
+
+		e := fmt.Sprintf("{0x%04x, 0x%04x, %d},", lo, hi, stride)
+		if hi <= 0xffff {
+			r16 = append(r16, e)
+			if hi <= unicode.MaxLatin1 {
+				latinOffset++
+			}
+		} else {
+			r32 = append(r32, e)
+		}
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "%s\n\npackage main\n\nimport \"unicode\"\n\n", comment(header, "//"))
+	fmt.Fprintf(b, "var %s = &unicode.RangeTable{\n", name)
+	if len(r16) > 0 {
+		fmt.Fprintf(b, "R16: []unicode.Range16{\n%s\n},\n", strings.Join(r16, "\n"))
+	}
+	if len(r32) > 0 {
+		fmt.Fprintf(b, "R32: []unicode.Range32{\n%s\n},\n", strings.Join(r32, "\n"))
+	}
+	if latinOffset > 0 {
+		fmt.Fprintf(b, "LatinOffset: %d,\n", latinOffset)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return fmt.Errorf("codegen: %w", err)
+	}
+	_, err = out.Write(src)
+	return err
+}
+
+// codegenC writes a sorted array of ranges and a binary search function.
+func codegenC(out io.Writer, header, name string, ranges [][2]rune) {
+	fmt.Fprintf(out, "/*\n%s\n */\n\n", comment(header, " *"))
+	fmt.Fprint(out, "#include <stdbool.h>\n#include <stddef.h>\n#include <stdint.h>\n\n")
+	fmt.Fprintf(out, "static const uint32_t %s_ranges[][2] = {\n", name)
+	for _, r := range ranges {
+		fmt.Fprintf(out, "\t{0x%04X, 0x%04X},\n", r[0], r[1])
+	}
+	fmt.Fprint(out, "};\n\n")
+	fmt.Fprintf(out, `bool %[1]s(uint32_t cp) {
+	size_t lo = 0, hi = sizeof(%[1]s_ranges) / sizeof(%[1]s_ranges[0]);
+	while (lo < hi) {
+		size_t mid = lo + (hi - lo) / 2;
+		if (cp < %[1]s_ranges[mid][0])
+			hi = mid;
+		else if (cp > %[1]s_ranges[mid][1])
+			lo = mid + 1;
+		else
+			return true;
+	}
+	return false;
+}
+`, name)
+}
+
+// codegenRust writes a sorted slice of ranges and a binary search function.
+func codegenRust(out io.Writer, header, name string, ranges [][2]rune) {
+	var (
+		fn     = snakeCase(name)
+		consts = strings.ToUpper(fn) + "_RANGES"
+	)
+	fmt.Fprintf(out, "%s\n\n", comment(header, "//"))
+	fmt.Fprintf(out, "const %s: &[(u32, u32)] = &[\n", consts)
+	for _, r := range ranges {
+		fmt.Fprintf(out, "    (0x%04X, 0x%04X),\n", r[0], r[1])
+	}
+	fmt.Fprint(out, "];\n\n")
+	fmt.Fprintf(out, `pub fn %s(c: char) -> bool {
+    let c = c as u32;
+    %s
+        .binary_search_by(|&(lo, hi)| {
+            if c < lo {
+                std::cmp::Ordering::Greater
+            } else if c > hi {
+                std::cmp::Ordering::Less
+            } else {
+                std::cmp::Ordering::Equal
+            }
+        })
+        .is_ok()
+}
+`, fn, consts)
+}
+
+// codegenPython writes a frozenset for small sets, or the ranges and a
+// function using bisect.
+func codegenPython(out io.Writer, header, name string, cps []rune, ranges [][2]rune) {
+	var (
+		fn     = snakeCase(name)
+		consts = "_" + strings.ToUpper(fn)
+	)
+	fmt.Fprintf(out, "%s\n\n", comment(header, "#"))
+
+	if len(cps) <= 256 {
+		fmt.Fprintf(out, "%s = frozenset((\n", consts)
+		for _, c := range cps {
+			fmt.Fprintf(out, "    0x%04X,\n", c)
+		}
+		fmt.Fprint(out, "))\n\n\n")
+		fmt.Fprintf(out, "def %s(c):\n    return ord(c) in %s\n", fn, consts)
+		return
+	}
+
+	fmt.Fprint(out, "import bisect\n\n")
+	fmt.Fprintf(out, "%s_STARTS = (\n", consts)
+	for _, r := range ranges {
+		fmt.Fprintf(out, "    0x%04X,\n", r[0])
+	}
+	fmt.Fprintf(out, ")\n%s_ENDS = (\n", consts)
+	for _, r := range ranges {
+		fmt.Fprintf(out, "    0x%04X,\n", r[1])
+	}
+	fmt.Fprint(out, ")\n\n\n")
+	fmt.Fprintf(out, `def %[1]s(c):
+    cp = ord(c)
+    i = bisect.bisect_right(%[2]s_STARTS, cp) - 1
+    return i >= 0 and cp <= %[2]s_ENDS[i]
+`, fn, consts)
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zstd/zmap"
+)
+
+// Commands to complete; aliases are left out.
+var completeCommands = []string{"list", "identify", "print", "search", "emoji",
+	"emojify", "demojify", "restriction", "idna", "sort", "translit", "style", "latex", "font",
+	"termwidth", "codegen", "config", "completion", "serve", "lsp", "xcompose", "help", "version"}
+
+// Flags with a value, and the function to complete that value; the first name
+// is the one that's completed.
+var completeFlags = []struct {
+	names []string
+	value func(cmd, cur string) []string
+}{
+	{[]string{"format", "f"}, func(cmd, cur string) []string {
+		l := []string{"all"}
+		if conf, err := loadConfig(); err == nil {
+			for _, p := range zmap.KeysOrdered(conf.presets) {
+				l = append(l, "@"+p)
+			}
+		}
+		return l
+	}},
+	{[]string{"as", "a"}, func(cmd, cur string) []string {
+		if cmd == "style" {
+			return sortedValues(unidata.Styles)
+		}
+		return []string{"list", "json", "json-typed", "table", "csv", "tsv",
+			"markdown", "html", "html-table", "template", "regex"}
+	}},
+	{[]string{"tone", "t", "tones"}, func(cmd, cur string) []string {
+		return []string{"none", "light", "mediumlight", "medium", "mediumdark", "dark", "all"}
+	}},
+	{[]string{"gender", "g", "genders"}, func(cmd, cur string) []string {
+		return []string{"person", "man", "woman", "all"}
+	}},
+	{[]string{"sort", "s"}, func(cmd, cur string) []string {
+		return []string{"cpoint", "name", "name-collated"}
+	}},
+	{[]string{"locale", "l"}, nil},
+	{[]string{"strength"}, func(cmd, cur string) []string {
+		return []string{"primary", "secondary", "tertiary", "quaternary", "identical"}
+	}},
+	{[]string{"scheme"}, func(cmd, cur string) []string { return sortedValues(unidata.TranslitSchemes) }},
+	{[]string{"width-model"}, func(cmd, cur string) []string { return sortedValues(unidata.WidthModels) }},
+	{[]string{"template"}, nil},
+	{[]string{"template-file"}, func(cmd, cur string) []string { return completeFiles(cur) }},
+	{[]string{"flavor"}, func(cmd, cur string) []string { return regexFlavors }},
+	{[]string{"lang"}, func(cmd, cur string) []string { return []string{"go", "c", "rust", "python"} }},
+	{[]string{"name"}, nil},
+	{[]string{"order"}, func(cmd, cur string) []string { return []string{"name", "start", "assigned", "age"} }},
+	{[]string{"listen"}, nil},
+	{[]string{"copy-col"}, func(cmd, cur string) []string {
+		if cmd == "emoji" {
+			return []string{"emoji", "name", "group", "subgroup", "cldr", "cldr_full", "cpoint", "shortcode"}
+		}
+		return knownColumns
+	}},
+}
+
+// Flags without a value.
+var completeBoolFlags = []string{"compact", "raw", "pager", "or", "json",
+	"json-typed", "verbose", "plain", "save", "copy", "help", "version"}
+
+// completion writes the completion script for a shell.
+func completion(out io.Writer, args []string) error {
+	if len(args) != 1 {
+		return errors.New("completion: need exactly one shell: bash, zsh, or fish")
+	}
+	shell, err := match(args[0], "bash", "zsh", "fish")
+	if err != nil {
+		return fmt.Errorf("completion: %w", err)
+	}
+	switch shell {
+	case "bash":
+		fmt.Fprint(out, completeBash)
+	case "zsh":
+		fmt.Fprint(out, completeZsh)
+	case "fish":
+		fmt.Fprint(out, completeFish)
+	}
+	return nil
+}
+
+// complete writes completions for the "uni __complete" command, one per line;
+// words are all words after "uni", the last of which is the word to complete
+// (which may be empty).
+func complete(out io.Writer, words []string) {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	var (
+		cur  = words[len(words)-1]
+		cmd  string
+		pos  []string // Positional arguments before the current word.
+		prev func(cmd, cur string) []string
+	)
+	for i := 0; i < len(words)-1; i++ {
+		w := words[i]
+		if w == "--" {
+			pos = append(pos, words[i+1:len(words)-1]...)
+			break
+		}
+		if strings.HasPrefix(w, "-") {
+			name, _, hasValue := strings.Cut(strings.TrimLeft(w, "-"), "=")
+			for _, f := range completeFlags {
+				if slices.Contains(f.names, name) && !hasValue {
+					if i == len(words)-2 {
+						prev = f.value
+						if prev == nil {
+							return // Free-form value.
+						}
+					}
+					i++
+				}
+			}
+			continue
+		}
+		if cmd == "" {
+			cmd = w
+			switch cmd {
+			case "ls", "l":
+				cmd = "list"
+			case "i", "id":
+				cmd = "identify"
+			case "s", "se":
+				cmd = "search"
+			case "e", "em", "emo", "emoj":
+				cmd = "emoji"
+			}
+			if c, err := match(cmd, completeCommands...); err == nil {
+				cmd = c
+			}
+			continue
+		}
+		pos = append(pos, w)
+	}
+
+	var l []string
+	switch {
+	case prev != nil:
+		l = prev(cmd, cur)
+	case strings.HasPrefix(cur, "-"):
+		for _, f := range completeFlags {
+			l = append(l, "-"+f.names[0])
+		}
+		for _, f := range completeBoolFlags {
+			l = append(l, "-"+f)
+		}
+		slices.Sort(l)
+	case cmd == "":
+		l = completeCommands
+	default:
+		l = completeArgs(cmd, pos, cur)
+	}
+
+	lcur := strings.ToLower(cur)
+	for _, c := range l {
+		if strings.HasPrefix(strings.ToLower(c), lcur) {
+			fmt.Fprintln(out, c)
+		}
+	}
+}
+
+// completeArgs completes the arguments for a command.
+func completeArgs(cmd string, pos []string, cur string) []string {
+	switch cmd {
+	case "list":
+		return append([]string{"all"}, listNames...)
+	case "print", "codegen", "xcompose":
+		return completeQuery(cur)
+	case "emoji":
+		return completeEmoji(cur)
+	case "termwidth":
+		if strings.HasPrefix(cur, "emoji:") {
+			return completeEmoji(cur)
+		}
+		return append(completeQuery(cur), "emoji:")
+	case "font":
+		if len(pos) == 0 {
+			return completeFiles(cur)
+		}
+		if strings.HasPrefix(cur, "emoji:") {
+			return completeEmoji(cur)
+		}
+		return append(completeQuery(cur), "emoji:")
+	case "idna":
+		if len(pos) == 0 {
+			return []string{"toascii", "tounicode", "check"}
+		}
+	case "config":
+		if len(pos) == 0 {
+			return []string{"show"}
+		}
+		if len(pos) == 1 {
+			return configCommands
+		}
+	case "completion":
+		if len(pos) == 0 {
+			return []string{"bash", "zsh", "fish"}
+		}
+	}
+	return nil
+}
+
+// completeQuery completes print queries: the prefixes, or the block, script,
+// category, or property names if there's a prefix.
+func completeQuery(cur string) []string {
+	pfx, _, ok := strings.Cut(cur, ":")
+	if !ok {
+		return []string{"all", "block:", "script:", "category:", "property:", "utf8:", "compose:", "latex:", "html:", "keysym:", "digraph:"}
+	}
+
+	var names []string
+	switch strings.ToLower(pfx) {
+	case "block", "b":
+		for _, b := range unidata.Blocks {
+			names = append(names, b.Name)
+		}
+	case "script", "s":
+		for k, s := range unidata.Scripts {
+			if k != unidata.ScriptUnknown {
+				names = append(names, s.Name)
+			}
+		}
+	case "category", "cat":
+		for _, c := range unidata.Categories {
+			names = append(names, c.Name)
+		}
+	case "property", "prop", "p":
+		for _, p := range unidata.Properties {
+			names = append(names, p.Name)
+		}
+	}
+	l := make([]string, 0, len(names))
+	for _, n := range names {
+		l = append(l, pfx+":"+completeName(n))
+	}
+	slices.Sort(l)
+	return l
+}
+
+// completeEmoji completes the emoji subgroups for "g:" and "group:", with an
+// optional "emoji:" prefix for the font and termwidth commands. The emoji
+// command matches on a substring, so these are never changed like block names.
+func completeEmoji(cur string) []string {
+	var emoji string
+	if strings.HasPrefix(cur, "emoji:") {
+		emoji, cur = "emoji:", cur[6:]
+	}
+	pfx, _, ok := strings.Cut(cur, ":")
+	if !ok {
+		return []string{emoji + "all", emoji + "group:", emoji + "shortcode:"}
+	}
+	if pfx != "g" && pfx != "group" {
+		return nil
+	}
+	l := make([]string, 0, len(unidata.EmojiSubgroups))
+	for _, s := range unidata.EmojiSubgroups {
+		l = append(l, emoji+pfx+":"+strings.ToLower(s.Name))
+	}
+	slices.Sort(l)
+	return l
+}
+
+// completeName makes a name that doesn't need quoting in the shell; the Find*
+// functions in unidata ignore the dashes.
+func completeName(n string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "-", "_", "-", "&", "").Replace(n))
+}
+
+// completeFiles completes filenames; directories end with a "/".
+func completeFiles(cur string) []string {
+	matches, _ := filepath.Glob(cur + "*")
+	for i, m := range matches {
+		if st, err := os.Stat(m); err == nil && st.IsDir() {
+			matches[i] += string(filepath.Separator)
+		}
+	}
+	return matches
+}
+
+// sortedValues gets the values of a map, sorted.
+func sortedValues[K comparable](m map[K]string) []string {
+	l := make([]string, 0, len(m))
+	for _, v := range m {
+		l = append(l, v)
+	}
+	slices.Sort(l)
+	return l
+}
+
+const completeBash = `# bash completion for uni; load with:
+#   source <(uni completion bash)
+_uni() {
+	local cur words cword
+	if declare -F _get_comp_words_by_ref >/dev/null; then
+		_get_comp_words_by_ref -n : cur words cword
+	else
+		cur=${COMP_WORDS[COMP_CWORD]} words=("${COMP_WORDS[@]}") cword=$COMP_CWORD
+	fi
+
+	local IFS=$'\n'
+	COMPREPLY=($(uni __complete "${words[@]:1:cword}" 2>/dev/null))
+	if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == *[:/] ]]; then
+		compopt -o nospace
+	fi
+	if declare -F __ltrim_colon_completions >/dev/null; then
+		__ltrim_colon_completions "$cur"
+	fi
+}
+complete -F _uni uni
+`
+
+const completeZsh = `#compdef uni
+# zsh completion for uni; load with:
+#   source <(uni completion zsh)
+# or save as _uni somewhere in $fpath.
+_uni() {
+	local -a all more done
+	all=(${(f)"$(uni __complete "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+	more=(${(M)all:#*[:/]})
+	done=(${all:#*[:/]})
+	(( $#done )) && compadd -Q -- $done
+	(( $#more )) && compadd -Q -S '' -- $more
+}
+if [[ $funcstack[1] == _uni ]]; then
+	_uni "$@"
+else
+	compdef _uni uni
+fi
+`
+
+const completeFish = `# fish completion for uni; load with:
+#   uni completion fish | source
+function __uni_complete
+	set -l words (commandline -opc)
+	set -e words[1]
+	uni __complete $words (commandline -ct) 2>/dev/null
+end
+complete -c uni -f -a '(__uni_complete)'
+`
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"zgo.at/uni/v2/unidata"
+)
+
+// composeCol gets the %(compose) column: all Compose sequences, separated by a
+// comma.
+func composeCol(info unidata.Codepoint) string { return strings.Join(composeList(info), ", ") }
+
+// composeList gets all Compose sequences for info, formatted for display.
+func composeList(info unidata.Codepoint) []string {
+	seqs := info.Compose()
+	l := make([]string, 0, len(seqs))
+	for _, s := range seqs {
+		l = append(l, unidata.FormatCompose(s))
+	}
+	return l
+}
+
+var reComposeKey = regexp.MustCompile(`<(\w+)>`)
+
+// findCompose finds all codepoints with a Compose sequence that starts with
+// keys; keys can be given as the characters to type ("e=") or as it's written
+// in a Compose file ("<Multi_key> <e> <equal>").
+func findCompose(keys string, found func(unidata.Codepoint)) error {
+	if strings.Contains(keys, "<") {
+		var b strings.Builder
+		for _, m := range reComposeKey.FindAllStringSubmatch(keys, -1) {
+			if m[1] == "Multi_key" {
+				continue
+			}
+			r, ok := unidata.FindComposeKey(m[1])
+			if !ok {
+				return fmt.Errorf("unknown key in Compose sequence: %q", m[0])
+			}
+			b.WriteRune(r)
+		}
+		keys = b.String()
+	}
+
+	n := 0
+	for _, info := range unidata.Codepoints {
+		if slices.ContainsFunc(info.Compose(), func(s string) bool { return strings.HasPrefix(s, keys) }) {
+			found(info)
+			n++
+		}
+	}
+	if n == 0 {
+		return fmt.Errorf("no Compose sequence starts with %q", keys)
+	}
+	return nil
+}
+
+// xcompose writes an .XCompose file with sequences for all characters in the
+// print query that don't have a Compose sequence yet, or all characters with a
+// digraph if the query is empty.
+//
+// The sequence is the RFC 1345 digraph, or the reverse of it if that conflicts
+// with an existing sequence. Characters for which both conflict (or don't have
+// a digraph) are written as a comment.
+func xcompose(out io.Writer, args []string) error {
+	var infos []unidata.Codepoint
+	if len(args) == 0 {
+		for _, info := range unidata.Codepoints {
+			if info.Digraph() != "" {
+				infos = append(infos, info)
+			}
+		}
+	} else {
+		err := findCodepoints(io.Discard, args, printAsList, func(info unidata.Codepoint) {
+			infos = append(infos, info)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	slices.SortFunc(infos, func(a, b unidata.Codepoint) int { return int(a.Codepoint - b.Codepoint) })
+	infos = slices.CompactFunc(infos, func(a, b unidata.Codepoint) bool { return a.Codepoint == b.Codepoint })
+
+	var (
+		added []string
+		lines []string
+	)
+	conflicts := func(keys string) bool {
+		if unidata.ComposeConflicts(keys) {
+			return true
+		}
+		return slices.ContainsFunc(added, func(a string) bool {
+			return strings.HasPrefix(a, keys) || strings.HasPrefix(keys, a)
+		})
+	}
+	for _, info := range infos {
+		if len(info.Compose()) > 0 {
+			continue
+		}
+		// ASCII is already on the keyboard.
+		if c := info.Category(); info.Codepoint < 0x80 || c == unidata.CatControl || c == unidata.CatSurrogate {
+			continue
+		}
+		keysym := info.KeySym()
+		if keysym == "" {
+			keysym = fmt.Sprintf("U%04X", info.Codepoint)
+		}
+		str := `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(string(info.Codepoint)) + `"`
+
+		var keys string
+		if d := info.Digraph(); utf8.RuneCountInString(d) == 2 {
+			rev := []rune(d)
+			slices.Reverse(rev)
+			for _, k := range []string{d, string(rev)} {
+				if !conflicts(k) {
+					keys = k
+					break
+				}
+			}
+		}
+		if keys == "" {
+			lines = append(lines, fmt.Sprintf("# No free sequence\t: %s\t%s # %s", str, keysym, info.Name()))
+			continue
+		}
+		added = append(added, keys)
+		lines = append(lines, fmt.Sprintf("%s\t: %s\t%s # %s", unidata.FormatCompose(keys), str, keysym, info.Name()))
+	}
+	if len(lines) == 0 {
+		return errNoMatches
+	}
+
+	fmt.Fprint(out, "# Generated by \"uni xcompose\"; the sequences are the RFC 1345 digraphs.\n"+
+		"# Save as ~/.XCompose to use it; the include keeps the default sequences.\n"+
+		"include \"%L\"\n\n")
+	for _, l := range lines {
+		fmt.Fprintln(out, l)
+	}
+	return nil
+}
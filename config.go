@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+	"zgo.at/zstd/zmap"
+)
+
+// Keys that can be set in the config file, in the order they're shown.
+var configKeys = []string{"format", "as", "tone", "gender", "pager"}
+
+// Commands that can have a section in the config file.
+var configCommands = []string{"list", "identify", "print", "search", "emoji",
+	"restriction", "idna", "sort", "translit", "style", "font", "termwidth", "codegen"}
+
+// uniConfig is the configuration from the config file(s).
+type uniConfig struct {
+	files    []string                     // Files that were loaded.
+	defaults map[string]map[string]string // Defaults for commands; "" is for all commands.
+	presets  map[string]string            // Named formats for -format @name.
+}
+
+// configFiles gets the config files to load: the files in $UNI_CONFIG if it's
+// set (separated by the OS path separator, ":" on most systems), or
+// $XDG_CONFIG_HOME/uni/config. An empty $UNI_CONFIG means no config file is
+// loaded.
+func configFiles() []string {
+	if env, ok := os.LookupEnv("UNI_CONFIG"); ok {
+		return slices.DeleteFunc(filepath.SplitList(env), func(s string) bool { return s == "" })
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil
+	}
+	return []string{filepath.Join(dir, "uni", "config")}
+}
+
+// loadConfig loads all config files; values in later files override earlier
+// ones. It's not an error if the default config file doesn't exist, but it is
+// for files in $UNI_CONFIG.
+//
+// The format is:
+//
+//	# Defaults for all commands.
+//	as = json
+//
+//	# Defaults for one command.
+//	[emoji]
+//	format = %(emoji h)%(tab)%name
+//	tone   = medium
+//
+//	# Presets for -format @name.
+//	[preset]
+//	keysym = 0x%(hex l:auto f:0): %(keysym l:auto q:":",) // %name
+func loadConfig() (uniConfig, error) {
+	_, explicit := os.LookupEnv("UNI_CONFIG")
+	conf := uniConfig{
+		defaults: make(map[string]map[string]string),
+		presets:  make(map[string]string),
+	}
+	for _, path := range configFiles() {
+		fp, err := os.Open(path)
+		if err != nil {
+			if !explicit && errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return conf, fmt.Errorf("config: %w", err)
+		}
+		err = conf.parse(fp, path)
+		fp.Close()
+		if err != nil {
+			return conf, err
+		}
+		conf.files = append(conf.files, path)
+	}
+	return conf, nil
+}
+
+func (c *uniConfig) parse(r io.Reader, path string) error {
+	var (
+		scan    = bufio.NewScanner(r)
+		lineno  int
+		section string
+	)
+	for scan.Scan() {
+		lineno++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if line[len(line)-1] != ']' {
+				return fmt.Errorf("%s:%d: no closing ] for section", path, lineno)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section != "preset" && !slices.Contains(configCommands, section) {
+				return fmt.Errorf("%s:%d: unknown section %q", path, lineno, section)
+			}
+			continue
+		}
+
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("%s:%d: need key = value", path, lineno)
+		}
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if len(v) > 1 && v[0] == '"' && v[len(v)-1] == '"' {
+			uq, err := strconv.Unquote(v)
+			if err != nil {
+				return fmt.Errorf("%s:%d: %w", path, lineno, err)
+			}
+			v = uq
+		}
+
+		if section == "preset" {
+			if k == "" || strings.ContainsAny(k, " \t") {
+				return fmt.Errorf("%s:%d: invalid preset name: %q", path, lineno, k)
+			}
+			c.presets[k] = v
+			continue
+		}
+		if !slices.Contains(configKeys, k) {
+			return fmt.Errorf("%s:%d: unknown key %q; valid keys are: %s",
+				path, lineno, k, strings.Join(configKeys, ", "))
+		}
+		if k == "pager" {
+			if _, err := strconv.ParseBool(v); err != nil {
+				return fmt.Errorf("%s:%d: pager: not a boolean: %q", path, lineno, v)
+			}
+		}
+		if c.defaults[section] == nil {
+			c.defaults[section] = make(map[string]string)
+		}
+		c.defaults[section][k] = v
+	}
+	return scan.Err()
+}
+
+// get a value for a command; the command's section takes precedence over the
+// defaults for all commands. The -as flag means something different for the
+// style command, so that's never taken from the global defaults.
+func (c uniConfig) get(cmd, key string) (string, bool) {
+	if v, ok := c.defaults[cmd][key]; ok {
+		return v, true
+	}
+	if cmd == "style" && key == "as" {
+		return "", false
+	}
+	v, ok := c.defaults[""][key]
+	return v, ok
+}
+
+// preset gets the format for -format @name.
+func (c uniConfig) preset(format string) (string, error) {
+	if !strings.HasPrefix(format, "@") {
+		return format, nil
+	}
+	p, ok := c.presets[format[1:]]
+	if !ok {
+		if len(c.presets) == 0 {
+			return "", fmt.Errorf("-format flag: no preset %q: no presets defined in the config file", format[1:])
+		}
+		return "", fmt.Errorf("-format flag: no preset %q; defined presets are: %s",
+			format[1:], strings.Join(zmap.KeysOrdered(c.presets), ", "))
+	}
+	return p, nil
+}
+
+// show writes the configuration in the same format as the config file. If cmd
+// is given only the values for that command are shown, with the defaults for
+// all commands merged in.
+func (c uniConfig) show(w io.Writer, cmd string) {
+	switch files := configFiles(); {
+	case len(files) == 0:
+		fmt.Fprintln(w, "# No config file loaded; $UNI_CONFIG is empty.")
+	case len(c.files) == 0:
+		fmt.Fprintln(w, "# No config file loaded; looked in:")
+		for _, f := range files {
+			fmt.Fprintf(w, "#   %s\n", f)
+		}
+	default:
+		fmt.Fprintln(w, "# Loaded from:")
+		for _, f := range c.files {
+			fmt.Fprintf(w, "#   %s\n", f)
+		}
+	}
+
+	section := func(name string, values map[string]string, keys []string) {
+		if len(values) == 0 {
+			return
+		}
+		fmt.Fprintln(w)
+		if name != "" {
+			fmt.Fprintf(w, "[%s]\n", name)
+		}
+		width := 0
+		for k := range values {
+			width = max(width, len(k))
+		}
+		for _, k := range keys {
+			if v, ok := values[k]; ok {
+				if v != strings.TrimSpace(v) || (len(v) > 1 && v[0] == '"' && v[len(v)-1] == '"') {
+					v = strconv.Quote(v)
+				}
+				fmt.Fprintf(w, "%-*s = %s\n", width, k, v)
+			}
+		}
+	}
+
+	if cmd != "" {
+		values := make(map[string]string)
+		for _, k := range configKeys {
+			if v, ok := c.get(cmd, k); ok {
+				values[k] = v
+			}
+		}
+		section(cmd, values, configKeys)
+		return
+	}
+
+	section("", c.defaults[""], configKeys)
+	for _, s := range configCommands {
+		section(s, c.defaults[s], configKeys)
+	}
+	section("preset", c.presets, zmap.KeysOrdered(c.presets))
+}
+
+// configCmd runs "uni config".
+func configCmd(conf uniConfig, args []string) error {
+	if len(args) == 0 {
+		return errors.New("config: need a subcommand: show")
+	}
+	sub, err := match(args[0], "show")
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+	switch sub {
+	case "show":
+		if len(args) > 2 {
+			return errors.New("config show: too many arguments")
+		}
+		cmd := ""
+		if len(args) == 2 {
+			cmd, err = match(args[1], configCommands...)
+			if err != nil {
+				return fmt.Errorf("config show: %w", err)
+			}
+		}
+		conf.show(zli.Stdout, cmd)
+	}
+	return nil
+}
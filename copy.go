@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// The column to copy to the clipboard; set from the -copy and -copy-col flags.
+var copyCol string
+
+// Open the terminal to write the OSC 52 escape to; this is /dev/tty rather than
+// stdout so it also works with -pager or when the output is redirected.
+var copyTTY = func() (io.WriteCloser, error) {
+	return os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+}
+
+// copyText copies text to the clipboard.
+//
+// On a local desktop this uses wl-copy or xclip if found, as not all terminals
+// support OSC 52 and the escape is silently ignored by those that don't.
+//
+// Over SSH, inside tmux, or if neither tool is found this uses the OSC 52
+// escape sequence, which is supported by most terminals and also works over
+// SSH as the terminal sets the clipboard. Inside tmux it's wrapped in a DCS
+// passthrough sequence; this needs "set -g allow-passthrough on" in tmux 3.3
+// and newer.
+func copyText(text string) error {
+	remote := os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" || os.Getenv("TMUX") != ""
+	if !remote {
+		if ok, err := copyTool(text); ok {
+			return err
+		}
+	}
+
+	tty, err := copyTTY()
+	if err != nil {
+		if remote {
+			if ok, err := copyTool(text); ok {
+				return err
+			}
+		}
+		return errors.New("no terminal to write the OSC 52 escape to, and wl-copy or xclip not found")
+	}
+	defer tty.Close()
+
+	_, err = io.WriteString(tty, osc52(text, os.Getenv("TMUX") != ""))
+	return err
+}
+
+// osc52 gets the OSC 52 sequence to set the clipboard to text.
+func osc52(text string, tmux bool) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if tmux {
+		seq = "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return seq
+}
+
+// copyTool copies text with wl-copy on Wayland, or xclip on X11. It returns
+// false if neither can be used.
+func copyTool(text string) (bool, error) {
+	var tools [][]string
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		tools = append(tools, []string{"wl-copy"})
+	}
+	if os.Getenv("DISPLAY") != "" {
+		tools = append(tools, []string{"xclip", "-selection", "clipboard"})
+	}
+	for _, t := range tools {
+		if _, err := exec.LookPath(t[0]); err != nil {
+			continue
+		}
+		// Don't capture the output: both fork to keep serving the selection,
+		// and that would wait until the fork exits.
+		cmd := exec.Command(t[0], t[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			return true, fmt.Errorf("%s: %w", t[0], err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// copyJoin joins the values to copy: characters and emojis are copied as one
+// string, everything else as one value per line.
+func copyJoin(col string, values []string) string {
+	if col == "char" || col == "emoji" {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values, "\n")
+}
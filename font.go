@@ -0,0 +1,416 @@
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"github.com/andybalholm/brotli"
+	"zgo.at/uni/v2/unidata"
+)
+
+// The codepoints covered by the font loaded with the font command, for
+// %(in_font) and -as table; nil if no font is loaded.
+var fontCoverage map[rune]bool
+
+var errFontTruncated = errors.New("truncated or corrupt font file")
+
+// Maximum size of a decompressed font table; even the largest CJK fonts are
+// well below this, and we don't want to allocate gigabytes because a header
+// says so.
+const maxFontTable = 256 << 20
+
+func inFont(info unidata.Codepoint) string {
+	if fontCoverage == nil {
+		return ""
+	}
+	if fontCoverage[info.Codepoint] {
+		return "yes"
+	}
+	return "no"
+}
+
+// loadFont reads the cmap table from a TrueType or OpenType font, and returns
+// all codepoints that are mapped to a glyph.
+//
+// This supports .ttf, .otf, .ttc (only the first font is used), .woff, and
+// .woff2 files.
+func loadFont(path string) (map[rune]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 12 {
+		return nil, errFontTruncated
+	}
+
+	var cmap []byte
+	switch string(data[:4]) {
+	case "\x00\x01\x00\x00", "OTTO", "true":
+		cmap, err = sfntGet(data, 0, "cmap")
+	case "ttcf":
+		if binary.BigEndian.Uint32(data[8:]) == 0 || len(data) < 16 {
+			return nil, errFontTruncated
+		}
+		cmap, err = sfntGet(data, int(binary.BigEndian.Uint32(data[12:])), "cmap")
+	case "wOFF":
+		cmap, err = woffGet(data, "cmap")
+	case "wOF2":
+		cmap, err = woff2Get(data, "cmap")
+	default:
+		return nil, fmt.Errorf("%s: not a TrueType, OpenType, or WOFF font", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	cov, err := parseCmap(cmap)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cov, nil
+}
+
+// sfntGet gets a table from the sfnt font starting at off.
+func sfntGet(data []byte, off int, tag string) ([]byte, error) {
+	if off < 0 || len(data) < off+12 {
+		return nil, errFontTruncated
+	}
+	n := int(binary.BigEndian.Uint16(data[off+4:]))
+	if len(data) < off+12+n*16 {
+		return nil, errFontTruncated
+	}
+	for i := 0; i < n; i++ {
+		rec := data[off+12+i*16:]
+		if string(rec[:4]) != tag {
+			continue
+		}
+		o, l := int(binary.BigEndian.Uint32(rec[8:])), int(binary.BigEndian.Uint32(rec[12:]))
+		if o+l > len(data) {
+			return nil, errFontTruncated
+		}
+		return data[o : o+l], nil
+	}
+	return nil, fmt.Errorf("no %s table", tag)
+}
+
+// woffGet gets a table from a WOFF 1.0 font; tables are compressed with
+// zlib, unless compressing didn't make it smaller.
+func woffGet(data []byte, tag string) ([]byte, error) {
+	if len(data) < 44 {
+		return nil, errFontTruncated
+	}
+	n := int(binary.BigEndian.Uint16(data[12:]))
+	if len(data) < 44+n*20 {
+		return nil, errFontTruncated
+	}
+	for i := 0; i < n; i++ {
+		rec := data[44+i*20:]
+		if string(rec[:4]) != tag {
+			continue
+		}
+		var (
+			o         = int(binary.BigEndian.Uint32(rec[4:]))
+			compLen   = int(binary.BigEndian.Uint32(rec[8:]))
+			origLen   = int(binary.BigEndian.Uint32(rec[12:]))
+			totalSfnt = int(binary.BigEndian.Uint32(data[16:]))
+		)
+		if o+compLen > len(data) || origLen > totalSfnt || origLen > maxFontTable {
+			return nil, errFontTruncated
+		}
+		if compLen == origLen {
+			return data[o : o+compLen], nil
+		}
+
+		z, err := zlib.NewReader(bytes.NewReader(data[o : o+compLen]))
+		if err != nil {
+			return nil, err
+		}
+		tbl := make([]byte, origLen)
+		_, err = io.ReadFull(z, tbl)
+		return tbl, err
+	}
+	return nil, fmt.Errorf("no %s table", tag)
+}
+
+type woff2Table struct {
+	tag      string
+	off, len int // In the decompressed stream.
+}
+
+// woff2Get gets a table from a WOFF 2.0 font; all tables are in a single
+// Brotli-compressed stream. We only need to decompress up to the end of the
+// table we want.
+//
+// Some tables (glyf, loca, hmtx) can be "transformed", but we never need
+// those.
+func woff2Get(data []byte, tag string) ([]byte, error) {
+	if len(data) < 48 {
+		return nil, errFontTruncated
+	}
+	var (
+		flavor   = string(data[4:8])
+		n        = int(binary.BigEndian.Uint16(data[12:]))
+		compSize = int(binary.BigEndian.Uint32(data[20:]))
+		pos      = 48
+		off      = 0
+		tables   = make([]woff2Table, 0, n)
+	)
+	for i := 0; i < n; i++ {
+		if pos >= len(data) {
+			return nil, errFontTruncated
+		}
+		flags := data[pos]
+		pos++
+
+		// There's a list of 63 known tags which are stored as an index; we only
+		// need to know about a few of them.
+		var t string
+		switch flags & 0x3f {
+		case 0x3f:
+			if pos+4 > len(data) {
+				return nil, errFontTruncated
+			}
+			t = string(data[pos : pos+4])
+			pos += 4
+		case 0:
+			t = "cmap"
+		case 10:
+			t = "glyf"
+		case 11:
+			t = "loca"
+		}
+
+		var origLen, transLen uint32
+		origLen, pos = uintBase128(data, pos)
+		l := origLen
+		// Version 0 means "transformed" for glyf and loca, and "not
+		// transformed" for everything else.
+		if v := flags >> 6; (t == "glyf" || t == "loca") == (v == 0) {
+			transLen, pos = uintBase128(data, pos)
+			l = transLen
+		}
+		if pos < 0 || off+int(l) > maxFontTable {
+			return nil, errFontTruncated
+		}
+		tables = append(tables, woff2Table{tag: t, off: off, len: int(l)})
+		off += int(l)
+	}
+
+	// Use the first font from collections.
+	want := -1
+	if flavor == "ttcf" {
+		var nfonts, ntables int
+		pos += 4 // Version
+		nfonts, pos = uint255(data, pos)
+		for i := 0; i < nfonts && pos >= 0; i++ {
+			ntables, pos = uint255(data, pos)
+			pos += 4 // Flavor
+			for j := 0; j < ntables && pos >= 0; j++ {
+				var idx int
+				idx, pos = uint255(data, pos)
+				if i == 0 && want == -1 && idx < len(tables) && tables[idx].tag == tag {
+					want = idx
+				}
+			}
+		}
+		if pos < 0 {
+			return nil, errFontTruncated
+		}
+	} else {
+		for i, t := range tables {
+			if t.tag == tag {
+				want = i
+				break
+			}
+		}
+	}
+	if want == -1 {
+		return nil, fmt.Errorf("no %s table", tag)
+	}
+	if pos+compSize > len(data) {
+		return nil, errFontTruncated
+	}
+
+	t := tables[want]
+	buf := make([]byte, t.off+t.len)
+	_, err := io.ReadFull(brotli.NewReader(bytes.NewReader(data[pos:pos+compSize])), buf)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing WOFF2: %w", err)
+	}
+	return buf[t.off:], nil
+}
+
+// Read a WOFF2 UIntBase128; pos is -1 on errors.
+func uintBase128(data []byte, pos int) (uint32, int) {
+	if pos < 0 {
+		return 0, -1
+	}
+	var n uint32
+	for i := 0; i < 5; i++ {
+		if pos >= len(data) || (i == 0 && data[pos] == 0x80) || n&0xfe000000 != 0 {
+			return 0, -1
+		}
+		b := data[pos]
+		pos++
+		n = n<<7 | uint32(b&0x7f)
+		if b&0x80 == 0 {
+			return n, pos
+		}
+	}
+	return 0, -1
+}
+
+// Read a WOFF2 255UInt16; pos is -1 on errors.
+func uint255(data []byte, pos int) (int, int) {
+	if pos < 0 || pos >= len(data) {
+		return 0, -1
+	}
+	switch code := data[pos]; code {
+	case 253:
+		if pos+3 > len(data) {
+			return 0, -1
+		}
+		return int(binary.BigEndian.Uint16(data[pos+1:])), pos + 3
+	case 254, 255:
+		if pos+2 > len(data) {
+			return 0, -1
+		}
+		return int(data[pos+1]) + map[byte]int{255: 253, 254: 506}[code], pos + 2
+	default:
+		return int(code), pos + 1
+	}
+}
+
+// Priority of cmap subtables, by platform and encoding ID; the ones with the
+// full Unicode repertoire are preferred over the BMP-only ones. Anything else
+// isn't Unicode.
+func cmapPriority(platform, encoding uint16) int {
+	switch {
+	case platform == 3 && encoding == 10, platform == 0 && (encoding == 4 || encoding == 6):
+		return 4
+	case platform == 3 && encoding == 1, platform == 0 && encoding <= 3:
+		return 3
+	case platform == 3 && encoding == 0: // Symbol fonts, mapped to U+F000..U+F0FF.
+		return 2
+	}
+	return 0
+}
+
+// parseCmap parses a cmap table, and returns all codepoints that map to a
+// glyph other than the .notdef glyph (glyph 0).
+func parseCmap(cmap []byte) (map[rune]bool, error) {
+	if len(cmap) < 4 {
+		return nil, errFontTruncated
+	}
+	n := int(binary.BigEndian.Uint16(cmap[2:]))
+	if len(cmap) < 4+n*8 {
+		return nil, errFontTruncated
+	}
+
+	var best, bestPrio int
+	for i := 0; i < n; i++ {
+		rec := cmap[4+i*8:]
+		p := cmapPriority(binary.BigEndian.Uint16(rec), binary.BigEndian.Uint16(rec[2:]))
+		if p > bestPrio {
+			best, bestPrio = int(binary.BigEndian.Uint32(rec[4:])), p
+		}
+	}
+	if bestPrio == 0 {
+		return nil, errors.New("no Unicode cmap subtable")
+	}
+	if best+2 > len(cmap) {
+		return nil, errFontTruncated
+	}
+
+	var (
+		t   = cmap[best:]
+		u16 = func(o int) uint16 { return binary.BigEndian.Uint16(t[o:]) }
+		u32 = func(o int) uint32 { return binary.BigEndian.Uint32(t[o:]) }
+		cov = make(map[rune]bool)
+	)
+	switch format := u16(0); format {
+	default:
+		return nil, fmt.Errorf("unsupported cmap format %d", format)
+
+	case 0: // Byte encoding.
+		if len(t) < 6+256 {
+			return nil, errFontTruncated
+		}
+		for i, g := range t[6 : 6+256] {
+			if g != 0 {
+				cov[rune(i)] = true
+			}
+		}
+
+	case 4: // Segments for the BMP.
+		if len(t) < 14 {
+			return nil, errFontTruncated
+		}
+		segX2 := int(u16(6))
+		if len(t) < 16+segX2*4 {
+			return nil, errFontTruncated
+		}
+		for i := 0; i < segX2; i += 2 {
+			var (
+				end, start = u16(14 + i), u16(16 + segX2 + i)
+				delta      = u16(16 + segX2*2 + i)
+				rangeOff   = 16 + segX2*3 + i
+			)
+			for c := uint32(start); c <= uint32(end) && c != 0xffff; c++ {
+				g := uint16(c) + delta
+				if ro := u16(rangeOff); ro != 0 {
+					o := rangeOff + int(ro) + int(c-uint32(start))*2
+					if o+2 > len(t) {
+						return nil, errFontTruncated
+					}
+					if g = u16(o); g != 0 {
+						g += delta
+					}
+				}
+				if g != 0 {
+					cov[rune(c)] = true
+				}
+			}
+		}
+
+	case 6: // Trimmed table.
+		if len(t) < 10 {
+			return nil, errFontTruncated
+		}
+		first, n := int(u16(6)), int(u16(8))
+		if len(t) < 10+n*2 {
+			return nil, errFontTruncated
+		}
+		for i := 0; i < n; i++ {
+			if u16(10+i*2) != 0 {
+				cov[rune(first+i)] = true
+			}
+		}
+
+	case 12, 13: // Segmented coverage, and many-to-one range mappings.
+		if len(t) < 16 {
+			return nil, errFontTruncated
+		}
+		n := int(u32(12))
+		if len(t) < 16+n*12 {
+			return nil, errFontTruncated
+		}
+		for i := 0; i < n; i++ {
+			start, end, g := u32(16+i*12), min(u32(20+i*12), utf8.MaxRune), u32(24+i*12)
+			for c := start; c <= end; c++ {
+				// Format 12 maps to consecutive glyphs, format 13 to the same
+				// glyph.
+				if (format == 12 && g+(c-start) != 0) || (format == 13 && g != 0) {
+					cov[rune(c)] = true
+				}
+			}
+		}
+	}
+	return cov, nil
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"math"
 	"os"
@@ -14,7 +16,6 @@ import (
 	"strings"
 	"unicode/utf8"
 
-	"zgo.at/termtext"
 	"zgo.at/uni/v2/unidata"
 	"zgo.at/zli"
 	"zgo.at/zstd/zmap"
@@ -63,11 +64,17 @@ type Format struct {
 	re        *regexp.Regexp // Cached regexp for format.
 	cols      []column       // Columns we know about.
 	colNames  []string
-	lines     [][]string // Processed lines, to be printed.
-	autoalign []int      // Max line lengths for autoalign.
-	ntrim     int        // Number of columns with "trim"
+	lines     [][]string            // Processed lines, to be printed.
+	tmplLines []map[string]string   // Lines for -template, with all columns.
+	lists     []map[string][]string // List columns for -as json-typed.
+	autoalign []int                 // Max line lengths for autoalign.
+	ntrim     int                   // Number of columns with "trim"
 
-	tblData []unidata.Codepoint
+	tblData []unidata.Codepoint // Codepoints for -as table and -as regex.
+	emojis  []string            // Emojis for -as regex.
+
+	copyCol string   // Column for -copy.
+	copied  []string // Values for -copy, in the same order as lines (without header).
 }
 
 var reFindCols = regexp.MustCompile(`%\((.*?)(?: .+?)?\)`)
@@ -82,15 +89,73 @@ const (
 	printAsJSONCompact
 	printAsTable
 	printAsTableCompact
+	printAsCSV
+	printAsCSVCompact
+	printAsTSV
+	printAsTSVCompact
+	printAsMarkdown
+	printAsMarkdownCompact
+	printAsHTML
+	printAsHTMLCompact
+	printAsHTMLTable
+	printAsHTMLTableCompact
+	printAsTemplate
+	printAsTemplateCompact
+	printAsRegex
+	printAsRegexCompact
+	printAsJSONTyped
+	printAsJSONTypedCompact
 )
 
+func (p printAs) String() string {
+	return map[printAs]string{
+		printAsList:      "list",
+		printAsJSON:      "json",
+		printAsTable:     "table",
+		printAsCSV:       "csv",
+		printAsTSV:       "tsv",
+		printAsMarkdown:  "markdown",
+		printAsHTML:      "html",
+		printAsHTMLTable: "html-table",
+		printAsTemplate:  "template",
+		printAsRegex:     "regex",
+		printAsJSONTyped: "json-typed",
+	}[p&^1]
+}
+
+func (p printAs) json() bool {
+	return p == printAsJSON || p == printAsJSONCompact || p == printAsJSONTyped || p == printAsJSONTypedCompact
+}
+
+// tbl reports if this prints a table of codepoints, rather than lines.
+func (p printAs) tbl() bool {
+	return p == printAsTable || p == printAsTableCompact ||
+		p == printAsHTMLTable || p == printAsHTMLTableCompact
+}
+
+// regex reports if this prints a regular expression for all codepoints or
+// emojis, rather than lines.
+func (p printAs) regex() bool { return p == printAsRegex || p == printAsRegexCompact }
+
 func header(h string) string {
 	h = strings.ReplaceAll(h, "-", " ")
 	switch h {
-	case "utf8", "utf16", "utf16le", "utf16be", "html", "xml", "json", "cldr":
+	case "utf8", "utf16", "utf16le", "utf16be", "html", "xml", "json", "cldr", "ascii":
 		return strings.ToUpper(h)
 	case "cpoint":
 		return "CPoint"
+	case "id_status":
+		return "ID status"
+	case "id_type":
+		return "ID type"
+	case "idna":
+		return "IDNA"
+	case "idna_mapping":
+		return "IDNA mapping"
+	case "in_font":
+		return "In font"
+	case "latex":
+		return "LaTeX"
 	default:
 		return zstring.UpperFirst(h)
 	}
@@ -98,9 +163,15 @@ func header(h string) string {
 
 func NewFormat(format string, as printAs, knownCols ...string) (*Format, error) {
 	f := Format{format: format, as: as}
+	if copyCol != "" && !slices.Contains(knownCols, copyCol) {
+		return nil, fmt.Errorf("-copy-col flag: unknown column: %q", copyCol)
+	}
 
-	if as == printAsTable || as == printAsTableCompact {
+	if as.tbl() || as.regex() {
 		// Don't need all the rest of the logic.
+		if copyCol != "" {
+			f.copyCol, f.colNames = copyCol, []string{copyCol}
+		}
 		return &f, nil
 	}
 
@@ -121,7 +192,7 @@ func NewFormat(format string, as printAs, knownCols ...string) (*Format, error)
 		}
 		cols = append(cols, c.name)
 
-		if f.json() {
+		if f.json() || f.csv() {
 			h[c.name] = c.name
 		} else if !c.noHeader {
 			h[c.name] = header(c.name)
@@ -134,6 +205,10 @@ func NewFormat(format string, as printAs, knownCols ...string) (*Format, error)
 	if as == printAsList {
 		f.Line(h)
 	}
+	if copyCol != "" {
+		// Set after adding the header, so that's not copied.
+		f.copyCol, f.colNames = copyCol, append(cols, copyCol)
+	}
 
 	// TODO: is this actually faster than just .*?
 	// TODO: don't really need to use regexp for this; can just scan for "%(name".
@@ -141,8 +216,11 @@ func NewFormat(format string, as printAs, knownCols ...string) (*Format, error)
 	return &f, nil
 }
 
-func (f *Format) tbl() bool  { return f.as == printAsTable || f.as == printAsTableCompact }
-func (f *Format) json() bool { return f.as == printAsJSON || f.as == printAsJSONCompact }
+func (f *Format) tbl() bool  { return f.as.tbl() }
+func (f *Format) json() bool { return f.as.json() }
+func (f *Format) csv() bool {
+	return f.as >= printAsCSV && f.as <= printAsTSVCompact
+}
 
 func (f *Format) processColumn(line string) error {
 	s := zstring.Fields(line[2:len(line)-1], " ") // name, flags
@@ -221,16 +299,31 @@ func (f *Format) processColumn(line string) error {
 }
 
 // Line adds a new line.
-func (f *Format) Line(columns map[string]string) error {
+func (f *Format) Line(columns map[string]string) error { return f.ListLine(columns, nil) }
+
+// ListLine adds a new line, with the values for the columns that are lists for
+// -as json-typed. The lists for codepoints are added automatically.
+func (f *Format) ListLine(columns map[string]string, lists map[string][]string) error {
+	if v, ok := columns[tmplCopy]; ok {
+		f.copied = append(f.copied, v)
+	} else if v, ok := columns[f.copyCol]; ok && f.copyCol != "" {
+		f.copied = append(f.copied, v)
+	}
 	if f.tbl() { // Don't need to do anything.
 		return nil
 	}
+	if f.as.regex() {
+		if _, ok := columns[tmplEmoji]; ok {
+			f.emojis = append(f.emojis, columns["emoji"])
+		}
+		return nil
+	}
 
 	line := make([]string, len(f.cols))
 	for i, c := range f.cols {
 		line[i] = columns[c.name]
 		if c.width == alignAuto {
-			l := termtext.Width(columns[c.name])
+			l := textWidth(columns[c.name])
 			if c.quote > 0 {
 				l += len(c.quoteChar[0]) + len(c.quoteChar[1])
 			}
@@ -240,6 +333,17 @@ func (f *Format) Line(columns map[string]string) error {
 		}
 	}
 	f.lines = append(f.lines, line)
+	if f.as == printAsTemplate || f.as == printAsTemplateCompact {
+		f.tmplLines = append(f.tmplLines, columns)
+	}
+	if f.as == printAsJSONTyped || f.as == printAsJSONTypedCompact {
+		if cp, ok := columns[tmplCodepoint]; ok && lists == nil {
+			r, _ := utf8.DecodeRuneInString(cp)
+			info, _ := unidata.Find(r)
+			lists = codepointLists(info)
+		}
+		f.lists = append(f.lists, lists)
+	}
 	return nil
 }
 
@@ -252,9 +356,21 @@ func (f *Format) Sort(col string) {
 			break
 		}
 	}
-	sort.Slice(f.lines, func(i, j int) bool {
-		return f.lines[i][coli] < f.lines[j][coli]
-	})
+	f.sortStable(func(a, b []string) int { return strings.Compare(a[coli], b[coli]) })
+}
+
+// SortFunc sorts by column with a comparison function, keeping the header in
+// place. It's an error if the column isn't in the format.
+func (f *Format) SortFunc(col string, cmp func(a, b string) int) error {
+	if f.tbl() || f.as.regex() {
+		return nil
+	}
+	coli := slices.IndexFunc(f.cols, func(c column) bool { return c.name == col })
+	if coli == -1 {
+		return fmt.Errorf("can't sort on %q: not in -format", col)
+	}
+	f.sortStable(func(a, b []string) int { return cmp(a[coli], b[coli]) })
+	return nil
 }
 
 func (f *Format) SortNum(col string) {
@@ -269,13 +385,63 @@ func (f *Format) SortNum(col string) {
 			break
 		}
 	}
-	sort.Slice(f.lines, func(i, j int) bool {
-		a, _ := strconv.Atoi(f.lines[i][coli])
-		b, _ := strconv.Atoi(f.lines[j][coli])
-		return a < b
+	f.sortStable(func(a, b []string) int {
+		x, _ := strconv.Atoi(a[coli])
+		y, _ := strconv.Atoi(b[coli])
+		return x - y
 	})
 }
 
+// sortStable sorts the lines, keeping the header in place and the lines for
+// -template and -copy in sync.
+func (f *Format) sortStable(cmp func(a, b []string) int) {
+	if f.tbl() || f.as.regex() {
+		return
+	}
+	hdr := 0
+	if f.as == printAsList && len(f.lines) > 0 {
+		hdr = 1
+	}
+	if f.tmplLines == nil && f.copied == nil && f.lists == nil {
+		slices.SortStableFunc(f.lines[hdr:], cmp)
+		return
+	}
+
+	idx := make([]int, len(f.lines)-hdr)
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortStableFunc(idx, func(a, b int) int { return cmp(f.lines[a+hdr], f.lines[b+hdr]) })
+	var (
+		lines  = append(make([][]string, 0, len(f.lines)), f.lines[:hdr]...)
+		tmpl   = make([]map[string]string, 0, len(f.tmplLines))
+		copied = make([]string, 0, len(f.copied))
+		lists  = make([]map[string][]string, 0, len(f.lists))
+	)
+	for _, i := range idx {
+		lines = append(lines, f.lines[i+hdr])
+		if f.tmplLines != nil {
+			tmpl = append(tmpl, f.tmplLines[i])
+		}
+		if f.copied != nil {
+			copied = append(copied, f.copied[i])
+		}
+		if f.lists != nil {
+			lists = append(lists, f.lists[i])
+		}
+	}
+	f.lines = lines
+	if f.tmplLines != nil {
+		f.tmplLines = tmpl
+	}
+	if f.copied != nil {
+		f.copied = copied
+	}
+	if f.lists != nil {
+		f.lists = lists
+	}
+}
+
 var (
 	hlKey = zli.ColorHex("af5f00").String()
 	hlStr = zli.ColorHex("cd0000").String()
@@ -306,6 +472,10 @@ func (f *Format) printJSON(out io.Writer) {
 	w := 0
 	out.Write([]byte("["))
 	for i, l := range f.lines {
+		var lists map[string][]string
+		if len(f.lists) > i {
+			lists = f.lists[i]
+		}
 		m := make(map[string]string, len(f.cols))
 		for j, c := range f.cols {
 			if c.name == "wide_padding" || c.name == "tab" {
@@ -317,7 +487,7 @@ func (f *Format) printJSON(out io.Writer) {
 			}
 		}
 
-		if f.as == printAsJSONCompact {
+		if f.as == printAsJSONCompact || f.as == printAsJSONTypedCompact {
 			if i > 0 {
 				fmt.Fprint(out, " ")
 			}
@@ -326,7 +496,7 @@ func (f *Format) printJSON(out io.Writer) {
 				if j > 0 {
 					out.Write([]byte(","))
 				}
-				fmt.Fprintf(out, "%s:%s", prKey(k), prStr(m[k]))
+				fmt.Fprintf(out, "%s:%s", prKey(k), f.jsonValue(k, m[k], lists, prStr))
 			}
 			fmt.Fprintf(out, "}")
 			if i != len(f.lines)-1 {
@@ -338,7 +508,7 @@ func (f *Format) printJSON(out io.Writer) {
 				if j > 0 {
 					out.Write([]byte(",\n"))
 				}
-				fmt.Fprintf(out, "\t%s: %s%s", prKey(k), strings.Repeat(" ", w-len(k)), prStr(m[k]))
+				fmt.Fprintf(out, "\t%s: %s%s", prKey(k), strings.Repeat(" ", w-len(k)), f.jsonValue(k, m[k], lists, prStr))
 			}
 			fmt.Fprintf(out, "\n}")
 			if i != len(f.lines)-1 {
@@ -349,6 +519,274 @@ func (f *Format) printJSON(out io.Writer) {
 	out.Write([]byte("]\n"))
 }
 
+// jsonValue formats a JSON value. Everything is a string, except for
+// -json-typed, which writes numbers as numbers and lists as arrays.
+func (f *Format) jsonValue(col, v string, lists map[string][]string, prStr func(string) string) string {
+	if f.as != printAsJSONTyped && f.as != printAsJSONTypedCompact {
+		return prStr(v)
+	}
+
+	switch col {
+	case "dec", "cells", "assigned", "covered", "measured", "size", "total", "subgroups", "emojis":
+		if _, err := strconv.Atoi(v); err == nil {
+			return v
+		}
+		return prStr(v)
+	}
+	list, ok := lists[col]
+	if !ok {
+		return prStr(v)
+	}
+
+	l := make([]string, 0, len(list))
+	for _, s := range list {
+		l = append(l, prStr(s))
+	}
+	if f.as == printAsJSONTypedCompact {
+		return "[" + strings.Join(l, ",") + "]"
+	}
+	return "[" + strings.Join(l, ", ") + "]"
+}
+
+// codepointLists gets the values of the codepoint columns that are lists.
+func codepointLists(info unidata.Codepoint) map[string][]string {
+	t := templateCodepoint{info: info}
+	return map[string][]string{
+		"props":   t.Props(),
+		"aliases": info.Aliases(),
+		"refs":    info.Refs(),
+		"compose": composeList(info),
+		"latex":   info.LaTeX(),
+		"id_type": t.IDType(),
+	}
+}
+
+// printCSV prints as CSV (RFC 4180) or TSV, with a header row of the column
+// names unless compact is set.
+func (f *Format) printCSV(out io.Writer) {
+	w := csv.NewWriter(out)
+	if f.as == printAsTSV || f.as == printAsTSVCompact {
+		w.Comma = '\t'
+	} else {
+		w.UseCRLF = true
+	}
+
+	cols := f.dataCols()
+	rec := make([]string, len(cols))
+	if f.as == printAsCSV || f.as == printAsTSV {
+		for i, c := range cols {
+			rec[i] = f.cols[c].name
+		}
+		w.Write(rec)
+	}
+	for _, l := range f.lines {
+		for i, c := range cols {
+			rec[i] = l[c]
+		}
+		w.Write(rec)
+	}
+	w.Flush()
+}
+
+// dataCols gets the indexes of the columns that contain data, skipping
+// columns that are only for alignment.
+func (f *Format) dataCols() []int {
+	cols := make([]int, 0, len(f.cols))
+	for i, c := range f.cols {
+		if c.name != "wide_padding" && c.name != "tab" {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// Escape everything that has a meaning inside a GitHub-flavoured Markdown
+// table cell.
+var mdEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`,
+	`>`, `\>`, `~`, `\~`, `|`, `\|`, `&`, `\&`, "\n", " ")
+
+// printMarkdown prints as a GitHub-flavoured Markdown pipe table; the columns
+// are padded to align unless compact is set.
+func (f *Format) printMarkdown(out io.Writer) {
+	var (
+		cols  = f.dataCols()
+		rows  = make([][]string, 0, len(f.lines)+1)
+		width = make([]int, len(cols))
+		pad   = f.as == printAsMarkdown
+	)
+	row := make([]string, len(cols))
+	for i, c := range cols {
+		row[i] = mdEscaper.Replace(header(f.cols[c].name))
+	}
+	rows = append(rows, row)
+	for _, l := range f.lines {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = mdEscaper.Replace(l[c])
+		}
+		rows = append(rows, row)
+	}
+	if pad {
+		for i := range width {
+			width[i] = 3
+		}
+		for _, row := range rows {
+			for i, t := range row {
+				width[i] = max(width[i], textWidth(t))
+			}
+		}
+	}
+
+	b := new(strings.Builder)
+	writeRow := func(row []string, right func(i int) bool) {
+		b.WriteString("|")
+		for i, t := range row {
+			if right(i) {
+				t = rightAlign(t, width[i])
+			} else {
+				t = leftAlign(t, width[i])
+			}
+			b.WriteString(" " + t + " |")
+		}
+		b.WriteString("\n")
+	}
+	right := func(i int) bool { return f.cols[cols[i]].align == alignRight }
+
+	writeRow(rows[0], right)
+	sep := make([]string, len(cols))
+	for i := range sep {
+		sep[i] = strings.Repeat("-", max(width[i], 3))
+		if right(i) {
+			sep[i] = sep[i][1:] + ":"
+		}
+	}
+	writeRow(sep, func(int) bool { return false })
+	for _, row := range rows[1:] {
+		writeRow(row, right)
+	}
+	io.WriteString(out, b.String())
+}
+
+// printHTML prints as a HTML table, wrapped in a standalone page unless compact
+// is set.
+func (f *Format) printHTML(out io.Writer) {
+	cols := f.dataCols()
+	class := func(c int) string {
+		if f.cols[c].align == alignRight {
+			return ` class="r"`
+		}
+		return ""
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("<table>\n<thead><tr>")
+	for _, c := range cols {
+		fmt.Fprintf(b, "<th%s>%s</th>", class(c), html.EscapeString(header(f.cols[c].name)))
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+	for _, l := range f.lines {
+		b.WriteString("<tr>")
+		for _, c := range cols {
+			fmt.Fprintf(b, "<td%s>%s</td>", class(c), html.EscapeString(l[c]))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	f.htmlPage(out, b.String())
+}
+
+// printHTMLTbl prints the same grid as printTbl as a HTML table.
+func (f *Format) printHTMLTbl(out io.Writer) {
+	sort.Slice(f.tblData, func(i, j int) bool {
+		return f.tblData[i].Codepoint < f.tblData[j].Codepoint
+	})
+	sel := make(map[rune]bool, len(f.tblData))
+	for _, c := range f.tblData {
+		sel[c.Codepoint] = true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString(`<table class="grid">` + "\n<thead><tr><th></th>")
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(b, "<th>%X</th>", i)
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+
+	start, end := f.tblData[0].Codepoint, f.tblData[len(f.tblData)-1].Codepoint
+	start -= start % 16 // Make sure we start at column 0
+	didel := false
+	for r := start; r <= end; r += 16 {
+		var (
+			row   = new(strings.Builder)
+			blank = 0
+		)
+		for i := r; i < r+16; i++ {
+			info, assigned := unidata.Codepoints[i]
+			switch {
+			case i > end:
+				blank++
+				row.WriteString(`<td></td>`)
+			case !assigned:
+				blank++
+				row.WriteString(`<td class="unassigned"></td>`)
+			case !sel[i]:
+				blank++
+				row.WriteString(`<td class="unselected"></td>`)
+			case fontCoverage != nil && !fontCoverage[i]:
+				fmt.Fprintf(row, `<td class="missing" title="%s %s">%s</td>`,
+					info.FormatCodepoint(), html.EscapeString(info.Name()), html.EscapeString(info.Display()))
+			default:
+				fmt.Fprintf(row, `<td title="%s %s">%s</td>`,
+					info.FormatCodepoint(), html.EscapeString(info.Name()), html.EscapeString(info.Display()))
+			}
+		}
+
+		if blank < 16 {
+			fmt.Fprintf(b, "<tr><th>U+%sx</th>%s</tr>\n", strings.TrimSuffix(fmt.Sprintf("%04X", r), "0"), row)
+			didel = false
+		} else if !didel {
+			b.WriteString(`<tr><th>…</th><td colspan="16"></td></tr>` + "\n")
+			didel = true
+		}
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	f.htmlPage(out, b.String())
+}
+
+// htmlPage writes body wrapped in a standalone HTML page, or just body if
+// compact is set.
+func (f *Format) htmlPage(out io.Writer, body string) {
+	if f.as == printAsHTMLCompact || f.as == printAsHTMLTableCompact {
+		io.WriteString(out, body)
+		return
+	}
+	io.WriteString(out, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>uni</title>
+<style>
+`+htmlStyle+`</style>
+</head>
+<body>
+`+body+`</body>
+</html>
+`)
+}
+
+// CSS for -as html and -as html-table; also used by "uni serve".
+const htmlStyle = `	body                  { font-family: sans-serif; margin: 2em; }
+	table                 { border-collapse: collapse; }
+	th, td                { border: 1px solid #ccc; padding: .2em .5em; text-align: left; }
+	th                    { background-color: #eee; }
+	.r                    { text-align: right; }
+	.grid td              { text-align: center; font-size: 1.5em; min-width: 1.5em; }
+	.grid th              { text-align: center; font-family: monospace; }
+	.grid .unassigned     { background-color: #eee; }
+	.grid .missing        { color: #bbb; }
+`
+
 func (f *Format) printTbl(out io.Writer) {
 	sort.Slice(f.tblData, func(i, j int) bool {
 		return f.tblData[i].Codepoint < f.tblData[j].Codepoint
@@ -360,7 +798,8 @@ func (f *Format) printTbl(out io.Writer) {
 		head   = 4
 	)
 	for _, c := range f.tblData {
-		if w := c.Width(); w == unidata.WidthFullWidth || w == unidata.WidthWide || w == unidata.WidthAmbiguous {
+		if w := c.Width(); w == unidata.WidthFullWidth || w == unidata.WidthWide || w == unidata.WidthAmbiguous ||
+			textWidth(string(c.Codepoint)) >= 2 {
 			wide = true
 		}
 		tblMap[c.Codepoint] = c.Display()
@@ -399,6 +838,12 @@ func (f *Format) printTbl(out io.Writer) {
 		} else if !ok { /// Not in selection.
 			blank++
 			char = zli.Colorize("·", zli.Color256(249))
+		} else if fontCoverage != nil && !fontCoverage[i] { /// Not in font.
+			if isTerm {
+				char = zli.Colorize(" ", zli.Color256(254).Bg())
+			} else {
+				char = "·"
+			}
 		}
 
 		if strings.HasSuffix(cp, "0") {
@@ -408,7 +853,7 @@ func (f *Format) printTbl(out io.Writer) {
 		// Need to add space for alignment if some codepoints are wide but this
 		// one isn't.
 		row += fmt.Sprintf(" %s ", char)
-		if wide && termtext.Width(char) == 1 {
+		if wide && textWidth(char) == 1 {
 			row += " "
 		}
 		if strings.HasSuffix(cp, "F") || i == end {
@@ -432,13 +877,42 @@ func (f *Format) printTbl(out io.Writer) {
 }
 
 func (f *Format) Print(out io.Writer) {
+	if f.copyCol != "" {
+		defer func() {
+			if err := copyText(copyJoin(f.copyCol, f.copied)); err != nil {
+				zli.Fatalf("-copy: %s", err)
+			}
+		}()
+	}
 	if f.json() {
 		f.printJSON(out)
 		return
 	}
-	if f.tbl() {
+	switch f.as {
+	case printAsTable, printAsTableCompact:
 		f.printTbl(out)
 		return
+	case printAsHTMLTable, printAsHTMLTableCompact:
+		f.printHTMLTbl(out)
+		return
+	case printAsCSV, printAsCSVCompact, printAsTSV, printAsTSVCompact:
+		f.printCSV(out)
+		return
+	case printAsMarkdown, printAsMarkdownCompact:
+		f.printMarkdown(out)
+		return
+	case printAsHTML, printAsHTMLCompact:
+		f.printHTML(out)
+		return
+	case printAsRegex, printAsRegexCompact:
+		f.printRegex(out)
+		return
+	case printAsTemplate, printAsTemplateCompact:
+		err := f.printTemplate(out)
+		if err != nil {
+			zli.Fatalf("-template: %s", err)
+		}
+		return
 	}
 
 	for lineno, l := range f.lines {
@@ -452,13 +926,13 @@ func (f *Format) Print(out io.Writer) {
 		// This line is too long and we want to trim: reformat the lot.
 		// TODO: this can be a bit more efficient: we know the column widths and
 		// text already, but this is easier.
-		w := termtext.Width(line)
+		w := textWidth(line)
 		if f.ntrim > 0 && w > termWidth {
 			tooLongBy := w - termWidth
 			var t = make([]int, len(f.cols))
 			for i, text := range l {
 				if f.cols[i].trim {
-					t[i] = termtext.Width(text)
+					t[i] = textWidth(text)
 				}
 			}
 			trim := nratio(tooLongBy, t...)
@@ -513,9 +987,9 @@ func (f *Format) fmtPlaceholder(i, lineno int, text string, applyTrim int) strin
 	}
 	switch c.align {
 	case alignLeft:
-		text = zstring.AlignLeft(text, w)
+		text = leftAlign(text, w)
 	case alignRight:
-		text = zstring.AlignRight(text, w)
+		text = rightAlign(text, w)
 	}
 	if c.fill > 0 {
 		if f.as == printAsListCompact || lineno > 0 {
@@ -524,7 +998,7 @@ func (f *Format) fmtPlaceholder(i, lineno int, text string, applyTrim int) strin
 	}
 
 	if c.trim && applyTrim > 0 {
-		text = zstring.ElideLeft(text, applyTrim)
+		text = elide(text, applyTrim)
 	}
 
 	return text
@@ -538,15 +1012,40 @@ func (f *Format) String() string {
 
 var knownColumns = []string{"char", "wide_padding", "cpoint", "dec", "hex",
 	"oct", "bin", "utf8", "utf16be", "utf16le", "html", "xml", "json", "keysym",
-	"digraph", "name", "cat", "block", "plane", "width", "cells", "props", "script",
-	"unicode", "aliases", "refs"}
+	"digraph", "compose", "latex", "name", "cat", "block", "plane", "width", "cells", "props", "script",
+	"unicode", "aliases", "refs", "id_status", "id_type", "idna", "idna_mapping", "sortkey", "ascii",
+	"in_font"}
 
 func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
-	if f.tbl() {
+	if f.tbl() || f.as.regex() {
 		f.tblData = append(f.tblData, info)
-		return nil
+		if f.copyCol == "char" {
+			f.copied = append(f.copied, string(info.Codepoint))
+		} else if f.copyCol != "" {
+			f.copied = append(f.copied, f.columns(info, raw)[f.copyCol])
+		}
+		if f.tbl() {
+			return nil
+		}
+		return map[string]string{}
 	}
 
+	cols := f.columns(info, raw)
+	if f.copyCol == "char" {
+		// Always copy the character itself, rather than the display form
+		// with a dotted circle or control picture.
+		cols[tmplCopy] = string(info.Codepoint)
+	}
+	if f.as == printAsTemplate || f.as == printAsTemplateCompact || f.as == printAsJSONTyped || f.as == printAsJSONTypedCompact {
+		cols[tmplCodepoint] = string(info.Codepoint)
+		if raw {
+			cols[tmplRaw] = "1"
+		}
+	}
+	return cols
+}
+
+func (f *Format) columns(info unidata.Codepoint, raw bool) map[string]string {
 	if len(f.cols) == len(knownColumns) { // Optimize printing all columns.
 		return map[string]string{
 			"char":         map[bool]string{false: info.Display(), true: string(info.Codepoint)}[raw],
@@ -564,17 +1063,26 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 			"json":         info.JSON(),
 			"keysym":       info.KeySym(),
 			"digraph":      info.Digraph(),
+			"compose":      composeCol(info),
+			"latex":        strings.Join(info.LaTeX(), ", "),
 			"name":         info.Name(),
 			"cat":          info.Category().String(),
 			"block":        info.Block().String(),
 			"plane":        info.Plane().String(),
 			"width":        info.Width().String(),
-			"cells":        strconv.Itoa(int(info.Cells())),
+			"cells":        strconv.Itoa(info.CellsWith(widthModel)),
 			"props":        info.Properties().String(),
 			"script":       info.Script().String(),
 			"unicode":      info.Unicode().String(),
 			"aliases":      strings.Join(info.Aliases(), ", "),
 			"refs":         strings.Join(info.Refs(), ", "),
+			"id_status":    info.IDStatus().String(),
+			"id_type":      info.IDType().String(),
+			"idna":         idnaStatus(info),
+			"idna_mapping": idnaMapping(info),
+			"sortkey":      sortKey(info),
+			"ascii":        info.ASCII(),
+			"in_font":      inFont(info),
 		}
 	}
 
@@ -650,7 +1158,7 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 		cols["width"] = info.Width().String()
 	}
 	if slices.Contains(f.colNames, "cells") {
-		cols["cells"] = strconv.Itoa(int(info.Cells()))
+		cols["cells"] = strconv.Itoa(info.CellsWith(widthModel))
 	}
 	if slices.Contains(f.colNames, "props") {
 		cols["props"] = info.Properties().String()
@@ -664,6 +1172,33 @@ func (f *Format) toLine(info unidata.Codepoint, raw bool) map[string]string {
 	if slices.Contains(f.colNames, "refs") {
 		cols["refs"] = strings.Join(info.Refs(), ", ")
 	}
+	if slices.Contains(f.colNames, "compose") {
+		cols["compose"] = composeCol(info)
+	}
+	if slices.Contains(f.colNames, "latex") {
+		cols["latex"] = strings.Join(info.LaTeX(), ", ")
+	}
+	if slices.Contains(f.colNames, "id_status") {
+		cols["id_status"] = info.IDStatus().String()
+	}
+	if slices.Contains(f.colNames, "id_type") {
+		cols["id_type"] = info.IDType().String()
+	}
+	if slices.Contains(f.colNames, "idna") {
+		cols["idna"] = idnaStatus(info)
+	}
+	if slices.Contains(f.colNames, "idna_mapping") {
+		cols["idna_mapping"] = idnaMapping(info)
+	}
+	if slices.Contains(f.colNames, "sortkey") {
+		cols["sortkey"] = sortKey(info)
+	}
+	if slices.Contains(f.colNames, "ascii") {
+		cols["ascii"] = info.ASCII()
+	}
+	if slices.Contains(f.colNames, "in_font") {
+		cols["in_font"] = inFont(info)
+	}
 	return cols
 }
 
@@ -688,8 +1223,25 @@ func tabOrSpace() string {
 	return "    "
 }
 
+func idnaStatus(info unidata.Codepoint) string {
+	s, _ := info.IDNA()
+	return s.String()
+}
+
+func idnaMapping(info unidata.Codepoint) string {
+	_, m := info.IDNA()
+	return m
+}
+
+func sortKey(info unidata.Codepoint) string {
+	return fmt.Sprintf("% x", collator.Key(string(info.Codepoint)))
+}
+
 func widePadding(info unidata.Codepoint) string {
-	if info.Width() != unidata.WidthFullWidth && info.Width() != unidata.WidthWide {
+	if w, ok := widthOverrides[string(info.Codepoint)]; ok {
+		return map[bool]string{true: "", false: " "}[w >= 2]
+	}
+	if info.CellsWith(widthModel) < 2 {
 		return " "
 	}
 	return ""
@@ -27,14 +27,14 @@ func main() {
 			}
 
 			for i := range args {
-				if strings.Index(args[i], " ") > -1 {
+				if strings.ContainsAny(args[i], " &;\\{}()<>|*?!$`'\"") {
 					args[i] = "'" + args[i] + "'"
 				}
 			}
 
 			out := "    % uni " + strings.Join(args, " ") + "\n"
 			for _, line := range bytes.Split(bytes.TrimRight(o, "\n"), []byte{'\n'}) {
-				out += "    " + string(line) + "\n"
+				out += strings.TrimRight("    "+string(line), " ") + "\n"
 			}
 			return out[:len(out)-1], nil
 		},
@@ -3,10 +3,15 @@ module zgo.at/uni/v2
 go 1.21.13
 
 require (
+	github.com/andybalholm/brotli v1.1.1
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/net v0.35.0
+	golang.org/x/term v0.29.0
+	golang.org/x/text v0.22.0
 	zgo.at/runewidth v0.1.0
 	zgo.at/termtext v1.5.0
 	zgo.at/zli v0.0.0-20240614180544-47534b1ce136
 	zgo.at/zstd v0.0.0-20240827020003-f7ed9341ec67
 )
 
-require github.com/rivo/uniseg v0.4.7 // indirect
+require golang.org/x/sys v0.30.0 // indirect
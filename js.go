@@ -0,0 +1,216 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"syscall/js"
+
+	"zgo.at/uni/v2/unidata"
+)
+
+func init() { jsAPI = exportJS }
+
+// exportJS sets globalThis.uni to the JavaScript API and waits forever; this is
+// used when uni is run as WebAssembly without arguments. See wasm/uni.js for
+// the functions and their options.
+//
+// Functions return the same JSON as "-as json-typed" (with "-format all" by
+// default) as a string, or an Error object on errors; wasm/uni.js parses the
+// JSON and throws the errors. Returning JSON rather than objects keeps the
+// order of the columns, and is a lot faster.
+func exportJS() {
+	isTerm = false
+	var err error
+	collator, err = newCollator("", "tertiary")
+	if err != nil {
+		panic(err)
+	}
+
+	u := unidata.Unicodes[unidata.UnicodeLatest]
+	api := map[string]any{"version": version, "unicode": u.Name}
+	for name, fn := range jsFuncs {
+		fn := fn
+		api[name] = js.FuncOf(func(this js.Value, args []js.Value) any {
+			v, err := fn(append(args, js.Undefined(), js.Undefined()))
+			if err != nil {
+				return js.Global().Get("Error").New(err.Error())
+			}
+			return v
+		})
+	}
+	js.Global().Set("uni", api)
+	select {}
+}
+
+// Functions for the JavaScript API; args always has at least two elements,
+// which are undefined if not given.
+var jsFuncs = map[string]func(args []js.Value) (string, error){
+	"identify": func(args []js.Value) (string, error) {
+		text, err := jsString(args[0], "identify: text")
+		if err != nil {
+			return "", err
+		}
+		opt := jsOpts(args[1])
+		return jsRun("identify", opt, func(out io.Writer, format string) error {
+			return identify(out, []string{text}, format, opt.bool("raw"), printAsJSONTypedCompact)
+		})
+	},
+	"search": func(args []js.Value) (string, error) {
+		terms, err := jsStrings(args[0], "search: terms")
+		if err != nil {
+			return "", err
+		}
+		opt := jsOpts(args[1])
+		sortBy, err := match(opt.string("sort", "cpoint"), "cpoint", "name", "name-collated")
+		if err != nil {
+			return "", fmt.Errorf("search: sort: %w", err)
+		}
+		return jsRun("search", opt, func(out io.Writer, format string) error {
+			return search(out, terms, format, opt.bool("raw"), printAsJSONTypedCompact, opt.bool("or"), sortBy)
+		})
+	},
+	"print": func(args []js.Value) (string, error) {
+		query, err := jsStrings(args[0], "print: query")
+		if err != nil {
+			return "", err
+		}
+		opt := jsOpts(args[1])
+		sortBy, err := match(opt.string("sort", "cpoint"), "cpoint", "name", "name-collated")
+		if err != nil {
+			return "", fmt.Errorf("print: sort: %w", err)
+		}
+		return jsRun("print", opt, func(out io.Writer, format string) error {
+			return print(out, query, format, opt.bool("raw"), printAsJSONTypedCompact, sortBy)
+		})
+	},
+	"emoji": func(args []js.Value) (string, error) {
+		query, err := jsStrings(args[0], "emoji: query")
+		if err != nil {
+			return "", err
+		}
+		opt := jsOpts(args[1])
+		tones, err := parseToneFlag(opt.string("tone", ""))
+		if err != nil {
+			return "", fmt.Errorf("emoji: %w", err)
+		}
+		genders, err := parseGenderFlag(opt.string("gender", "person"))
+		if err != nil {
+			return "", fmt.Errorf("emoji: %w", err)
+		}
+		return jsRun("emoji", opt, func(out io.Writer, format string) error {
+			return emoji(out, query, format, opt.bool("raw"), printAsJSONTypedCompact, opt.bool("or"), tones, genders)
+		})
+	},
+	"lookup": func(args []js.Value) (string, error) {
+		var (
+			info unidata.Codepoint
+			ok   bool
+		)
+		switch args[0].Type() {
+		case js.TypeNumber:
+			info, ok = unidata.Find(rune(args[0].Int()))
+		case js.TypeString:
+			var err error
+			info, err = unidata.FromString(args[0].String())
+			if err != nil {
+				return "", fmt.Errorf("lookup: invalid codepoint: %q", args[0].String())
+			}
+			ok = info.Name() != "CODEPOINT NOT IN UNICODE"
+		default:
+			return "", errors.New("lookup: codepoint must be a number or string")
+		}
+		if !ok {
+			return "null", nil
+		}
+
+		j, err := jsRun("print", jsOpts(args[1]), func(out io.Writer, format string) error {
+			f, err := NewFormat(format, printAsJSONTypedCompact, knownColumns...)
+			if err != nil {
+				return err
+			}
+			f.Line(f.toLine(info, false))
+			f.Print(out)
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+		var l []json.RawMessage
+		if err := json.Unmarshal([]byte(j), &l); err != nil || len(l) != 1 {
+			return "", fmt.Errorf("lookup: invalid JSON: %s", j)
+		}
+		return string(l[0]), nil
+	},
+}
+
+// jsRun runs a command with the format from opt (default "all"), and returns
+// the JSON output. No matches is an empty array rather than an error.
+func jsRun(cmd string, opt jsOpt, run func(out io.Writer, format string) error) (string, error) {
+	format := opt.string("format", "all")
+	format = expandFormat(cmd, format, format, true, cmd == "emoji")
+
+	buf := new(bytes.Buffer)
+	err := run(buf, format)
+	if err == errNoMatches {
+		return "[]", nil
+	}
+	return buf.String(), err
+}
+
+// jsOpt is an options object; it may be undefined.
+type jsOpt struct{ js.Value }
+
+func jsOpts(v js.Value) jsOpt {
+	if v.Type() != js.TypeObject {
+		return jsOpt{js.Undefined()}
+	}
+	return jsOpt{v}
+}
+
+func (o jsOpt) get(name string) js.Value {
+	if o.IsUndefined() {
+		return o.Value
+	}
+	return o.Get(name)
+}
+
+func (o jsOpt) bool(name string) bool { return o.get(name).Truthy() }
+
+func (o jsOpt) string(name, def string) string {
+	if v := o.get(name); v.Type() == js.TypeString {
+		return v.String()
+	}
+	return def
+}
+
+func jsString(v js.Value, what string) (string, error) {
+	if v.Type() != js.TypeString {
+		return "", fmt.Errorf("%s must be a string", what)
+	}
+	return v.String(), nil
+}
+
+// jsStrings gets a list of arguments from an array of strings, or a string
+// which is split on whitespace.
+func jsStrings(v js.Value, what string) ([]string, error) {
+	switch {
+	case v.Type() == js.TypeString:
+		return strings.Fields(v.String()), nil
+	case v.InstanceOf(js.Global().Get("Array")):
+		l := make([]string, 0, v.Length())
+		for i := 0; i < v.Length(); i++ {
+			if v.Index(i).Type() != js.TypeString {
+				return nil, fmt.Errorf("%s must be a string or an array of strings", what)
+			}
+			l = append(l, v.Index(i).String())
+		}
+		return l, nil
+	}
+	return nil, fmt.Errorf("%s must be a string or an array of strings", what)
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zli"
+)
+
+// Commands to style text, and the style to use; \mathrm and \text are also
+// accepted, and write their argument as-is.
+var latexStyles = map[string]unidata.Style{
+	`\mathbf`:   unidata.StyleBold,
+	`\mathit`:   unidata.StyleItalic,
+	`\mathcal`:  unidata.StyleScript,
+	`\mathscr`:  unidata.StyleScript,
+	`\mathfrak`: unidata.StyleFraktur,
+	`\mathbb`:   unidata.StyleDoubleStruck,
+	`\mathsf`:   unidata.StyleSans,
+	`\mathtt`:   unidata.StyleMonospace,
+}
+
+// Spacing commands, and commands that are ignored.
+var latexSpaces = map[string]string{
+	`\,`: "\u2009", `\:`: "\u205f", `\;`: "\u2004", `\ `: " ", `\!`: "",
+	`\left`: "", `\right`: "",
+}
+
+// latex converts LaTeX-ish math notation to Unicode text.
+//
+// The result is NFC-normalized, so that e.g. \not\in is ∉ rather than ∈ with a
+// combining slash.
+//
+// Superscripts, subscripts, and styles that can't be written in Unicode are
+// kept as e.g. "^(iπ)" or unstyled, with a warning on stderr.
+func latex(out io.Writer, args []string) error {
+	text := strings.TrimRight(strings.Join(args, " "), "\n")
+	s, err := latexToText([]rune(text), func(msg string) {
+		fmt.Fprintf(zli.Stderr, "uni: latex: %s\n", msg)
+	})
+	if err != nil {
+		return fmt.Errorf("latex: %w", err)
+	}
+	fmt.Fprintln(out, norm.NFC.String(s))
+	return nil
+}
+
+// latexToText converts text to Unicode: commands are replaced with the
+// character for the LaTeX name, ^ and _ with superscript and subscript
+// characters, commands such as \mathbb with the Mathematical Alphanumeric
+// Symbols, and accents such as \hat with combining characters. Braces are
+// removed, and everything else is kept as-is.
+//
+// warn is called for superscripts, subscripts, and styles that can't be
+// converted.
+func latexToText(text []rune, warn func(string)) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; c {
+		default:
+			b.WriteRune(c)
+		case '}':
+			return "", errors.New(`unbalanced "}"`)
+		case '{':
+			arg, n, err := latexArg(text[i:])
+			if err != nil {
+				return "", err
+			}
+			s, err := latexToText(arg, warn)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(s)
+			i += n - 1
+		case '^', '_':
+			arg, n, err := latexArg(text[i+1:])
+			if err != nil {
+				return "", err
+			}
+			s, err := latexToText(arg, warn)
+			if err != nil {
+				return "", err
+			}
+			st := unidata.StyleSuperscript
+			if c == '_' {
+				st = unidata.StyleSubscript
+			}
+			styled, err := latexStyle(strings.ReplaceAll(s, "-", "−"), st)
+			if err != nil {
+				styled = string(c) + s
+				if utf8.RuneCountInString(s) > 1 {
+					styled = string(c) + "(" + s + ")"
+				}
+				warn(fmt.Sprintf("%s; writing %s", err, styled))
+			}
+			b.WriteString(styled)
+			i += n
+		case '\\':
+			cmd := latexCmd(text[i:])
+			i += len(cmd) - 1
+			if s, ok := latexSpaces[string(cmd)]; ok {
+				b.WriteString(s)
+				continue
+			}
+
+			st, isStyle := latexStyles[string(cmd)]
+			if isStyle || string(cmd) == `\mathrm` || string(cmd) == `\text` {
+				arg, n, err := latexArg(text[i+1:])
+				if err != nil {
+					return "", fmt.Errorf("%s: %w", string(cmd), err)
+				}
+				s, err := latexToText(arg, warn)
+				if err != nil {
+					return "", err
+				}
+				if isStyle {
+					styled, err := latexStyle(s, st)
+					if err != nil {
+						warn(fmt.Sprintf("%s: %s; writing %q unstyled", string(cmd), err, s))
+						styled = s
+					}
+					s = styled
+				}
+				b.WriteString(s)
+				i += n
+				continue
+			}
+
+			r, ok := unidata.FindLaTeX(string(cmd))
+			if !ok {
+				return "", fmt.Errorf("unknown command: %s", string(cmd))
+			}
+			// Accents are written after every character of the argument.
+			if unicode.Is(unicode.Mn, r) {
+				arg, n, err := latexArg(text[i+1:])
+				if err != nil {
+					return "", fmt.Errorf("%s: %w", string(cmd), err)
+				}
+				s, err := latexToText(arg, warn)
+				if err != nil {
+					return "", err
+				}
+				for _, c := range s {
+					b.WriteRune(c)
+					if c != ' ' {
+						b.WriteRune(r)
+					}
+				}
+				i += n
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// latexCmd gets the command at the start of text: a backslash followed by
+// letters, or by a single other character (e.g. "\{").
+func latexCmd(text []rune) []rune {
+	if len(text) < 2 {
+		return text
+	}
+	if !isASCIILetter(text[1]) {
+		return text[:2]
+	}
+	n := 2
+	for n < len(text) && isASCIILetter(text[n]) {
+		n++
+	}
+	return text[:n]
+}
+
+func isASCIILetter(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') }
+
+// latexArg gets the argument at the start of text, after skipping spaces: a
+// group in braces (without the braces), a command, or a single character. It
+// also returns the number of characters it used.
+func latexArg(text []rune) ([]rune, int, error) {
+	n := 0
+	for n < len(text) && text[n] == ' ' {
+		n++
+	}
+	if n == len(text) {
+		return nil, 0, errors.New("missing argument")
+	}
+
+	switch text[n] {
+	case '}':
+		return nil, 0, errors.New("missing argument")
+	case '\\':
+		cmd := latexCmd(text[n:])
+		return cmd, n + len(cmd), nil
+	case '{':
+		depth := 0
+		for i := n; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					return text[n+1 : i], i + 1, nil
+				}
+			}
+		}
+		return nil, 0, errors.New(`unbalanced "{"`)
+	}
+	return text[n : n+1], n + 1, nil
+}
+
+// latexStyle converts s to the given style, with an error if a character has no
+// variant in that style. Spaces and characters that already have the style (as
+// in "e^{x^2}") are kept as-is.
+func latexStyle(s string, st unidata.Style) (string, error) {
+	var b strings.Builder
+	for _, r := range s {
+		styled := unidata.Stylize(string(r), st)
+		plain := unidata.Unstyle(styled)
+		if styled == string(r) && r != ' ' && (plain == styled || unidata.Stylize(plain, st) != styled) {
+			return "", fmt.Errorf("no %s variant for %q", st, r)
+		}
+		b.WriteString(styled)
+	}
+	return b.String(), nil
+}
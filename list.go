@@ -0,0 +1,359 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"zgo.at/uni/v2/unidata"
+	"zgo.at/zstd/zmap"
+)
+
+// Everything the list command can list, in the order for "list all".
+var listNames = []string{"blocks", "categories", "scripts", "properties",
+	"planes", "unicode", "emoji-groups", "emoji-subgroups"}
+
+// listType is something the list command can list.
+type listType struct {
+	format  string                       // Default format.
+	cols    []string                     // All columns.
+	entries func(as printAs) []listEntry // Entries in the default order.
+}
+
+// listEntry is a single line for the list command; name, start, assigned, and
+// age are used for -order.
+type listEntry struct {
+	name     string
+	start    rune
+	assigned int
+	age      unidata.Unicode
+	cols     map[string]string
+}
+
+var listTypes = map[string]listType{
+	"blocks": {
+		"%(from r:auto)  %(to r:auto)  %(assigned l:auto)  %(name l:auto)",
+		[]string{"from", "to", "range", "size", "assigned", "age", "plane", "name"},
+		listBlocks,
+	},
+	"categories": {
+		"%(short l:auto)  %(name l:auto)  %(assigned r:auto)  %(composed-of l:auto)",
+		[]string{"short", "name", "assigned", "age", "composed-of"},
+		listCategories,
+	},
+	"scripts": {
+		"%(name l:auto)  %(assigned r:auto)",
+		[]string{"code", "name", "from", "assigned", "age"},
+		listScripts,
+	},
+	"properties": {
+		"%(name l:auto)  %(assigned r:auto)",
+		[]string{"name", "assigned", "age"},
+		listProperties,
+	},
+	"planes": {
+		"%(from l:7) - %(to l:8)  %(name)",
+		[]string{"from", "to", "range", "size", "assigned", "age", "name"},
+		listPlanes,
+	},
+	"unicode": {
+		"%(version l:6) %(released)",
+		[]string{"version", "released", "assigned", "total"},
+		listUnicode,
+	},
+	"emoji-groups": {
+		"%(name l:auto)  %(subgroups r:auto)  %(emojis r:auto)",
+		[]string{"name", "subgroups", "emojis"},
+		listEmojiGroups,
+	},
+	"emoji-subgroups": {
+		"%(group l:auto)  %(name l:auto)  %(emojis r:auto)",
+		[]string{"group", "name", "emojis"},
+		listEmojiSubgroups,
+	},
+}
+
+// listFormat gets the format for a list type from the -format flag, which can
+// be empty for the default, "all" for all columns, or start with "+" to add
+// columns to the default.
+func (l listType) listFormat(format string) string {
+	switch {
+	case format == "":
+		return l.format
+	case format == "all":
+		cols := make([]string, 0, len(l.cols))
+		for _, c := range l.cols {
+			switch c {
+			case "size", "assigned", "total", "subgroups", "emojis":
+				cols = append(cols, "%("+c+" r:auto)")
+			default:
+				cols = append(cols, "%("+c+" l:auto)")
+			}
+		}
+		return strings.Join(cols, "  ")
+	case strings.HasPrefix(format, "+"):
+		return l.format + "  " + format[1:]
+	default:
+		return format
+	}
+}
+
+// orderList sorts the entries by name, start, assigned, or age. Entries
+// without an age (because nothing is assigned) are sorted last.
+func orderList(entries []listEntry, order string) {
+	switch order {
+	case "name":
+		slices.SortStableFunc(entries, func(a, b listEntry) int {
+			return strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name))
+		})
+	case "start":
+		slices.SortStableFunc(entries, func(a, b listEntry) int { return int(a.start - b.start) })
+	case "assigned":
+		slices.SortStableFunc(entries, func(a, b listEntry) int { return a.assigned - b.assigned })
+	case "age":
+		slices.SortStableFunc(entries, func(a, b listEntry) int {
+			if a.age == 0 || b.age == 0 {
+				return int(b.age) - int(a.age)
+			}
+			return int(a.age) - int(b.age)
+		})
+	}
+}
+
+// countRange counts the assigned codepoints in a range, and gets the earliest
+// Unicode version for these codepoints.
+func countRange(rng [2]rune, n *int, age *unidata.Unicode) {
+	for r := rng[0]; r <= rng[1]; r++ {
+		info, ok := unidata.Find(r)
+		if !ok {
+			continue
+		}
+		*n++
+		if u := info.Unicode(); *age == 0 || u < *age {
+			*age = u
+		}
+	}
+}
+
+func fmtAge(u unidata.Unicode) string {
+	if u == 0 {
+		return ""
+	}
+	return u.String()
+}
+
+// Codepoints in the list mode are aligned, but not in other modes as it's kind
+// of pointless.
+func fmtListCp(as printAs) string {
+	if as == printAsList || as == printAsListCompact {
+		return "% 7X"
+	}
+	return "%X"
+}
+
+func listBlocks(as printAs) []listEntry {
+	fmtCp := fmtListCp(as)
+	l := make([]listEntry, 0, len(unidata.Blocks))
+	for _, b := range unidata.Blocks {
+		e := listEntry{name: b.Name, start: b.Range[0]}
+		countRange(b.Range, &e.assigned, &e.age)
+		e.cols = map[string]string{
+			"from":     fmt.Sprintf(fmtCp, b.Range[0]),
+			"to":       fmt.Sprintf(fmtCp, b.Range[1]),
+			"range":    fmt.Sprintf("U+%04X..U+%04X", b.Range[0], b.Range[1]),
+			"size":     strconv.Itoa(int(b.Range[1] - b.Range[0] + 1)),
+			"assigned": strconv.Itoa(e.assigned),
+			"age":      fmtAge(e.age),
+			"plane":    unidata.Codepoint{Codepoint: b.Range[0]}.Plane().String(),
+			"name":     b.Name,
+		}
+		l = append(l, e)
+	}
+	slices.SortFunc(l, func(a, b listEntry) int { return int(a.start - b.start) })
+	return l
+}
+
+func listPlanes(as printAs) []listEntry {
+	l := make([]listEntry, 0, len(unidata.Planes))
+	for _, k := range zmap.KeysOrdered(unidata.Planes) {
+		p := unidata.Planes[k]
+		e := listEntry{name: p.Name, start: p.Range[0]}
+		countRange(p.Range, &e.assigned, &e.age)
+		e.cols = map[string]string{
+			"from":     fmt.Sprintf("U+%04X", p.Range[0]),
+			"to":       fmt.Sprintf("U+%04X", p.Range[1]),
+			"range":    fmt.Sprintf("U+%04X..U+%04X", p.Range[0], p.Range[1]),
+			"size":     strconv.Itoa(int(p.Range[1] - p.Range[0] + 1)),
+			"assigned": strconv.Itoa(e.assigned),
+			"age":      fmtAge(e.age),
+			"name":     p.Name,
+		}
+		l = append(l, e)
+	}
+	return l
+}
+
+func listScripts(as printAs) []listEntry {
+	fmtCp := fmtListCp(as)
+	l := make([]listEntry, 0, len(unidata.Scripts))
+	for _, k := range zmap.KeysOrdered(unidata.Scripts) {
+		s := unidata.Scripts[k]
+		if k == unidata.ScriptUnknown {
+			continue
+		}
+		e := listEntry{name: s.Name, start: unicode.MaxRune}
+		for _, rng := range s.Ranges {
+			countRange(rng, &e.assigned, &e.age)
+			e.start = min(e.start, rng[0])
+		}
+		e.cols = map[string]string{
+			"code":     k.Code(),
+			"name":     s.Name,
+			"from":     fmt.Sprintf(fmtCp, e.start),
+			"assigned": strconv.Itoa(e.assigned),
+			"age":      fmtAge(e.age),
+		}
+		l = append(l, e)
+	}
+	return l
+}
+
+func listProperties(as printAs) []listEntry {
+	l := make([]listEntry, 0, len(unidata.Properties))
+	for _, p := range unidata.Properties {
+		e := listEntry{name: p.Name, start: unicode.MaxRune}
+		for _, rng := range p.Ranges {
+			countRange(rng, &e.assigned, &e.age)
+			e.start = min(e.start, rng[0])
+		}
+		e.cols = map[string]string{
+			"name":     p.Name,
+			"assigned": strconv.Itoa(e.assigned),
+			"age":      fmtAge(e.age),
+		}
+		l = append(l, e)
+	}
+	slices.SortFunc(l, func(a, b listEntry) int { return strings.Compare(a.name, b.name) })
+	return l
+}
+
+func listCategories(as printAs) []listEntry {
+	var (
+		assign = make(map[unidata.Category]int)
+		age    = make(map[unidata.Category]unidata.Unicode)
+		start  = make(map[unidata.Category]rune)
+	)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		info, ok := unidata.Find(r)
+		if !ok {
+			continue
+		}
+		c := info.Category()
+		assign[c]++
+		if a, ok := age[c]; !ok || info.Unicode() < a {
+			age[c] = info.Unicode()
+		}
+		if _, ok := start[c]; !ok {
+			start[c] = r
+		}
+	}
+
+	l := make([]listEntry, 0, len(unidata.Categories))
+	for _, k := range zmap.KeysOrdered(unidata.Categories) {
+		c := unidata.Categories[k]
+		e := listEntry{name: c.Name, start: unicode.MaxRune, assigned: assign[k], age: age[k]}
+		if s, ok := start[k]; ok {
+			e.start = s
+		}
+
+		var comp []string
+		for _, i := range c.Include {
+			comp = append(comp, unidata.Categories[i].ShortName)
+			e.assigned += assign[i]
+			if s, ok := start[i]; ok {
+				e.start = min(e.start, s)
+			}
+			if a := age[i]; a != 0 && (e.age == 0 || a < e.age) {
+				e.age = a
+			}
+		}
+
+		e.cols = map[string]string{
+			"short":       c.ShortName,
+			"name":        c.Name,
+			"assigned":    strconv.Itoa(e.assigned),
+			"age":         fmtAge(e.age),
+			"composed-of": strings.Join(comp, " | "),
+		}
+		l = append(l, e)
+	}
+	return l
+}
+
+func listUnicode(as printAs) []listEntry {
+	assign := make(map[unidata.Unicode]int)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if info, ok := unidata.Find(r); ok {
+			assign[info.Unicode()]++
+		}
+	}
+
+	var (
+		l     = make([]listEntry, 0, len(unidata.Unicodes))
+		total int
+	)
+	for _, k := range zmap.KeysOrdered(unidata.Unicodes) {
+		if k == unidata.UnicodeLatest {
+			continue
+		}
+		u := unidata.Unicodes[k]
+		total += assign[k]
+		l = append(l, listEntry{name: u.Name, assigned: assign[k], age: k, cols: map[string]string{
+			"version":  u.Name,
+			"released": u.Released,
+			"assigned": strconv.Itoa(assign[k]),
+			"total":    strconv.Itoa(total),
+		}})
+	}
+	return l
+}
+
+func listEmojiGroups(as printAs) []listEntry {
+	count := make(map[unidata.EmojiGroup]int)
+	for _, e := range unidata.Emojis {
+		count[e.Group()]++
+	}
+
+	l := make([]listEntry, 0, len(unidata.EmojiGroups))
+	for i, k := range zmap.KeysOrdered(unidata.EmojiGroups) {
+		g := unidata.EmojiGroups[k]
+		l = append(l, listEntry{name: g.Name, start: rune(i), assigned: count[k], cols: map[string]string{
+			"name":      g.Name,
+			"subgroups": strconv.Itoa(len(g.Subgroups)),
+			"emojis":    strconv.Itoa(count[k]),
+		}})
+	}
+	return l
+}
+
+func listEmojiSubgroups(as printAs) []listEntry {
+	count := make(map[unidata.EmojiSubgroup]int)
+	for _, e := range unidata.Emojis {
+		count[e.Subgroup()]++
+	}
+
+	l := make([]listEntry, 0, len(unidata.EmojiSubgroups))
+	for _, gk := range zmap.KeysOrdered(unidata.EmojiGroups) {
+		for _, k := range unidata.EmojiGroups[gk].Subgroups {
+			s := unidata.EmojiSubgroups[k]
+			l = append(l, listEntry{name: s.Name, start: rune(len(l)), assigned: count[k], cols: map[string]string{
+				"group":  unidata.EmojiGroups[gk].Name,
+				"name":   s.Name,
+				"emojis": strconv.Itoa(count[k]),
+			}})
+		}
+	}
+	return l
+}
@@ -0,0 +1,778 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"zgo.at/uni/v2/unidata"
+)
+
+// lsp runs "uni lsp", a language server on stdin and stdout which provides:
+//
+//   - hover with the details of the character under the cursor;
+//   - diagnostics for invisible characters, bidirectional control characters,
+//     words that mix scripts (such as a Cyrillic "а" in a Latin word), and
+//     words that look like a Latin word but are in another script;
+//   - code actions to replace a character with an escape or an ASCII
+//     equivalent, or to remove invisible characters;
+//   - completion of :shortcode and \codepoint-name.
+//
+// Documents are always synced in full, and positions are in UTF-16 code units
+// as that's the only position encoding all clients support.
+func lsp(in io.Reader, out io.Writer) error {
+	var (
+		r = bufio.NewReader(in)
+		s = &lspServer{out: out, docs: make(map[string]lspDoc)}
+	)
+	for {
+		msg, err := lspRead(r)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			// The body isn't valid JSON; we know where the next message starts,
+			// so report it and continue.
+			var lerr *lspError
+			if errors.As(err, &lerr) {
+				if err := s.write(map[string]any{"jsonrpc": "2.0", "id": nil, "error": lerr}); err != nil {
+					return fmt.Errorf("lsp: %w", err)
+				}
+				continue
+			}
+			return fmt.Errorf("lsp: %w", err)
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("lsp: exit without shutdown")
+			}
+			return nil
+		}
+
+		result, err := s.handle(msg)
+		if msg.ID == nil { // Notification; never send a response.
+			continue
+		}
+		resp := map[string]any{"jsonrpc": "2.0", "id": msg.ID}
+		if err != nil {
+			var lerr *lspError
+			if !errors.As(err, &lerr) {
+				lerr = &lspError{Code: lspInternalError, Message: err.Error()}
+			}
+			resp["error"] = lerr
+		} else {
+			resp["result"] = result
+		}
+		if err := s.write(resp); err != nil {
+			return fmt.Errorf("lsp: %w", err)
+		}
+	}
+}
+
+type (
+	lspMsg struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	lspError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	lspServer struct {
+		out      io.Writer
+		docs     map[string]lspDoc // By URI.
+		shutdown bool
+	}
+	lspDoc struct {
+		lang  string   // Language ID; used for escapes.
+		lines []string // Lines, without the line ending.
+	}
+
+	lspPos struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	}
+	lspRange struct {
+		Start lspPos `json:"start"`
+		End   lspPos `json:"end"`
+	}
+	lspDocID struct {
+		URI string `json:"uri"`
+	}
+	lspTextEdit struct {
+		Range   lspRange `json:"range"`
+		NewText string   `json:"newText"`
+	}
+	lspDiagnostic struct {
+		Range    lspRange `json:"range"`
+		Severity int      `json:"severity"`
+		Code     string   `json:"code"`
+		Source   string   `json:"source"`
+		Message  string   `json:"message"`
+	}
+	lspCodeAction struct {
+		Title       string          `json:"title"`
+		Kind        string          `json:"kind"`
+		Diagnostics []lspDiagnostic `json:"diagnostics,omitempty"`
+		IsPreferred bool            `json:"isPreferred,omitempty"`
+		Edit        struct {
+			Changes map[string][]lspTextEdit `json:"changes"`
+		} `json:"edit"`
+	}
+	lspCompletionItem struct {
+		Label      string      `json:"label"`
+		Kind       int         `json:"kind"`
+		Detail     string      `json:"detail"`
+		FilterText string      `json:"filterText"`
+		SortText   string      `json:"sortText"`
+		TextEdit   lspTextEdit `json:"textEdit"`
+	}
+)
+
+func (e *lspError) Error() string { return e.Message }
+
+// Error codes and severities from the specification.
+const (
+	lspParseError     = -32700
+	lspInvalidRequest = -32600
+	lspMethodNotFound = -32601
+	lspInvalidParams  = -32602
+	lspInternalError  = -32603
+
+	lspSeverityWarning = 2
+	lspSeverityInfo    = 3
+)
+
+// lspRead reads a single message.
+func lspRead(r *bufio.Reader) (lspMsg, error) {
+	var (
+		msg lspMsg
+		n   = -1
+	)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return msg, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), "Content-Length") {
+			n, err = strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return msg, fmt.Errorf("invalid Content-Length: %q", v)
+			}
+		}
+	}
+	if n < 0 {
+		return msg, errors.New("no Content-Length header")
+	}
+
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return msg, err
+	}
+	if err := json.Unmarshal(buf, &msg); err != nil {
+		return msg, &lspError{Code: lspParseError, Message: "invalid message: " + err.Error()}
+	}
+	return msg, nil
+}
+
+func (s *lspServer) write(msg any) error {
+	j, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(j), j)
+	return err
+}
+
+func (s *lspServer) notify(method string, params any) error {
+	return s.write(map[string]any{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+func (s *lspServer) handle(msg lspMsg) (any, error) {
+	if s.shutdown && msg.Method != "exit" {
+		return nil, &lspError{Code: lspInvalidRequest, Message: "server is shut down"}
+	}
+	params := func(v any) error {
+		if err := json.Unmarshal(msg.Params, v); err != nil {
+			return &lspError{Code: lspInvalidParams, Message: err.Error()}
+		}
+		return nil
+	}
+
+	switch msg.Method {
+	case "initialize":
+		return map[string]any{
+			"serverInfo": map[string]string{"name": "uni", "version": version},
+			"capabilities": map[string]any{
+				"textDocumentSync":   1, // Full
+				"hoverProvider":      true,
+				"codeActionProvider": map[string]any{"codeActionKinds": []string{"quickfix", "refactor.rewrite"}},
+				"completionProvider": map[string]any{"triggerCharacters": []string{":", `\`}},
+			},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "initialized", "$/cancelRequest", "$/setTrace", "workspace/didChangeConfiguration":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var p struct {
+			TextDocument struct {
+				URI        string `json:"uri"`
+				LanguageID string `json:"languageId"`
+				Text       string `json:"text"`
+			} `json:"textDocument"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		s.docs[p.TextDocument.URI] = lspDoc{lang: p.TextDocument.LanguageID, lines: lspLines(p.TextDocument.Text)}
+		return nil, s.publish(p.TextDocument.URI)
+	case "textDocument/didChange":
+		var p struct {
+			TextDocument   lspDocID `json:"textDocument"`
+			ContentChanges []struct {
+				Text string `json:"text"`
+			} `json:"contentChanges"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil, nil
+		}
+		d := s.docs[p.TextDocument.URI]
+		d.lines = lspLines(p.ContentChanges[len(p.ContentChanges)-1].Text)
+		s.docs[p.TextDocument.URI] = d
+		return nil, s.publish(p.TextDocument.URI)
+	case "textDocument/didClose":
+		var p struct {
+			TextDocument lspDocID `json:"textDocument"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		delete(s.docs, p.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics",
+			map[string]any{"uri": p.TextDocument.URI, "diagnostics": []lspDiagnostic{}})
+
+	case "textDocument/hover":
+		var p struct {
+			TextDocument lspDocID `json:"textDocument"`
+			Position     lspPos   `json:"position"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		return s.hover(s.docs[p.TextDocument.URI], p.Position)
+	case "textDocument/codeAction":
+		var p struct {
+			TextDocument lspDocID `json:"textDocument"`
+			Range        lspRange `json:"range"`
+			Context      struct {
+				Diagnostics []lspDiagnostic `json:"diagnostics"`
+			} `json:"context"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		return s.codeActions(p.TextDocument.URI, p.Range, p.Context.Diagnostics), nil
+	case "textDocument/completion":
+		var p struct {
+			TextDocument lspDocID `json:"textDocument"`
+			Position     lspPos   `json:"position"`
+		}
+		if err := params(&p); err != nil {
+			return nil, err
+		}
+		return s.complete(s.docs[p.TextDocument.URI], p.Position), nil
+	}
+
+	if strings.HasPrefix(msg.Method, "$/") { // Optional; can be ignored.
+		return nil, nil
+	}
+	return nil, &lspError{Code: lspMethodNotFound, Message: fmt.Sprintf("method not supported: %q", msg.Method)}
+}
+
+// lspLines splits text in lines; LSP lines end with \n, \r\n, or \r.
+func lspLines(text string) []string {
+	return strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(text), "\n")
+}
+
+// lspOffset gets the byte offset in line for a UTF-16 position.
+func lspOffset(line string, char int) int {
+	n := 0
+	for i, r := range line {
+		if n >= char {
+			return i
+		}
+		n += lspRuneLen(r)
+	}
+	return len(line)
+}
+
+// lspChar gets the UTF-16 position for a byte offset in line.
+func lspChar(line string, offset int) int {
+	n := 0
+	for _, r := range line[:offset] {
+		n += lspRuneLen(r)
+	}
+	return n
+}
+
+// lspRuneLen gets the number of UTF-16 code units for r.
+func lspRuneLen(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// lspRuneRange gets the range for the character at the byte offset in line.
+func lspRuneRange(lineno int, line string, offset int) lspRange {
+	_, size := utf8.DecodeRuneInString(line[offset:])
+	return lspRange{
+		Start: lspPos{lineno, lspChar(line, offset)},
+		End:   lspPos{lineno, lspChar(line, offset+size)},
+	}
+}
+
+func lspName(info unidata.Codepoint) string { return info.FormatCodepoint() + " " + info.Name() }
+
+// hover shows the "-format all" columns for the character under the cursor.
+func (s *lspServer) hover(doc lspDoc, pos lspPos) (any, error) {
+	if pos.Line >= len(doc.lines) {
+		return nil, nil
+	}
+	line := doc.lines[pos.Line]
+	off := lspOffset(line, pos.Character)
+	if off >= len(line) {
+		return nil, nil
+	}
+	r, _ := utf8.DecodeRuneInString(line[off:])
+	info, ok := unidata.Find(r)
+	if !ok {
+		return nil, nil
+	}
+
+	f, err := NewFormat(expandFormat("identify", "all", "", true, false), printAsList, knownColumns...)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cols  = f.toLine(info, false)
+		width = 0
+		b     = new(strings.Builder)
+	)
+	for _, c := range f.cols {
+		width = max(width, len(header(c.name)))
+	}
+	fmt.Fprintf(b, "`%s` %s\n\n```text\n", info.Display(), lspName(info))
+	for _, c := range f.cols {
+		if v := cols[c.name]; v != "" && c.name != "char" && c.name != "wide_padding" {
+			fmt.Fprintf(b, "%-*s  %s\n", width, header(c.name), v)
+		}
+	}
+	b.WriteString("```\n")
+
+	return map[string]any{
+		"contents": map[string]string{"kind": "markdown", "value": b.String()},
+		"range":    lspRuneRange(pos.Line, line, off),
+	}, nil
+}
+
+func (s *lspServer) publish(uri string) error {
+	return s.notify("textDocument/publishDiagnostics",
+		map[string]any{"uri": uri, "diagnostics": lspDiagnostics(s.docs[uri].lines)})
+}
+
+// lspInvisible reports if this character is invisible, excluding the regular
+// space and tab.
+func lspInvisible(info unidata.Codepoint) bool {
+	switch info.Category() {
+	case unidata.CatFormat, unidata.CatLineSeparator, unidata.CatParagraphSeparator:
+		return true
+	case unidata.CatSpaceSeparator:
+		return info.Codepoint != ' '
+	case unidata.CatControl:
+		return info.Codepoint != '\t'
+	}
+	return slices.Contains(info.Properties(), unidata.PropOtherDefaultIgnorableCodePoint)
+}
+
+// lspJoined reports if runes[i] is a ZWJ or variation selector that's part of
+// the grapheme cluster: a ZWJ is only kept in the cluster if it joins two
+// emojis, and a single variation selector after a character selects its
+// presentation. Anything else is reported as invisible.
+func lspJoined(runes []rune, i int) bool {
+	if i == 0 {
+		return false
+	}
+	switch r := runes[i]; {
+	case r == 0x200d:
+		return i < len(runes)-1
+	case unicode.Is(unicode.Variation_Selector, r):
+		return !unicode.Is(unicode.Variation_Selector, runes[i-1])
+	}
+	return false
+}
+
+func lspBidi(info unidata.Codepoint) bool {
+	return slices.Contains(info.Properties(), unidata.PropBidiControl)
+}
+
+// lspDiagnostics gets diagnostics for invisible characters, bidirectional
+// control characters, and confusable words.
+//
+// Words that mix scripts in ways UTS #39 considers "Minimally Restrictive",
+// such as Latin and Cyrillic, report the characters not in the word's main
+// script. Words in a single script other than Latin are reported if their
+// skeleton from confusables.txt is a Latin word, such as the Cyrillic
+// "раураӏ".
+func lspDiagnostics(lines []string) []lspDiagnostic {
+	diags := make([]lspDiagnostic, 0, 4)
+	for lineno, line := range lines {
+		g := uniseg.NewGraphemes(line)
+		for g.Next() {
+			runes := g.Runes()
+			off, _ := g.Positions()
+			for i, r := range runes {
+				size := utf8.RuneLen(r)
+				if r < 0x80 && r != 0x7f && (r >= 0x20 || r == '\t') {
+					off += size
+					continue
+				}
+				if lspJoined(runes, i) {
+					off += size
+					continue
+				}
+
+				info, _ := unidata.Find(r)
+				switch {
+				case lspBidi(info):
+					diags = append(diags, lspDiagnostic{
+						Range:    lspRuneRange(lineno, line, off),
+						Severity: lspSeverityWarning,
+						Code:     "bidi",
+						Source:   "uni",
+						Message:  lspName(info) + ": bidirectional control character; the text may display differently than it's read",
+					})
+				case lspInvisible(info):
+					diags = append(diags, lspDiagnostic{
+						Range:    lspRuneRange(lineno, line, off),
+						Severity: lspSeverityWarning,
+						Code:     "invisible",
+						Source:   "uni",
+						Message:  lspName(info) + ": invisible character",
+					})
+				}
+				off += size
+			}
+		}
+
+		diags = append(diags, lspConfusable(lineno, line)...)
+	}
+	return diags
+}
+
+// lspConfusable reports characters in words that mix scripts, and words that
+// are whole-script confusable with a Latin word.
+func lspConfusable(lineno int, line string) []lspDiagnostic {
+	var diags []lspDiagnostic
+	isWord := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) }
+	for start := 0; start < len(line); {
+		r, size := utf8.DecodeRuneInString(line[start:])
+		if !isWord(r) {
+			start += size
+			continue
+		}
+		end := start
+		for end < len(line) {
+			r, size := utf8.DecodeRuneInString(line[end:])
+			if !isWord(r) {
+				break
+			}
+			end += size
+		}
+
+		word := line[start:end]
+		switch level, scripts := unidata.Restriction(word); level {
+		case unidata.RestrictionSingleScript:
+			if d, ok := lspWholeScript(lineno, line, start, end, scripts); ok {
+				diags = append(diags, d)
+			}
+		case unidata.RestrictionMinimallyRestrictive:
+			// The main script is the one used by the most characters.
+			count := make(map[unidata.Script]int)
+			for _, r := range word {
+				info, _ := unidata.Find(r)
+				for _, sc := range info.ScriptExtensions() {
+					count[sc]++
+				}
+			}
+			primary := scripts[0]
+			for _, sc := range scripts {
+				if count[sc] > count[primary] {
+					primary = sc
+				}
+			}
+
+			for i, r := range word {
+				info, _ := unidata.Find(r)
+				scx := info.ScriptExtensions()
+				if slices.Contains(scx, primary) || slices.Contains(scx, unidata.ScriptCommon) || slices.Contains(scx, unidata.ScriptInherited) {
+					continue
+				}
+				diags = append(diags, lspDiagnostic{
+					Range:    lspRuneRange(lineno, line, start+i),
+					Severity: lspSeverityInfo,
+					Code:     "mixed-script",
+					Source:   "uni",
+					Message: fmt.Sprintf("%s: %s character in a %s word; this may be confused with a %s character",
+						lspName(info), info.Script(), primary, primary),
+				})
+			}
+		}
+		start = end
+	}
+	return diags
+}
+
+// lspWholeScript reports the word in line[start:end] if it's in a single
+// script other than Latin, and looks like a Latin word.
+func lspWholeScript(lineno int, line string, start, end int, scripts []unidata.Script) (lspDiagnostic, bool) {
+	if slices.Contains(scripts, unidata.ScriptLatin) || slices.Contains(scripts, unidata.ScriptCommon) {
+		return lspDiagnostic{}, false
+	}
+	skel := unidata.Skeleton(line[start:end])
+	level, latin := unidata.Restriction(skel)
+	if (level != unidata.RestrictionASCIIOnly && level != unidata.RestrictionSingleScript) || !slices.Equal(latin, []unidata.Script{unidata.ScriptLatin}) {
+		return lspDiagnostic{}, false
+	}
+	return lspDiagnostic{
+		Range: lspRange{
+			Start: lspPos{lineno, lspChar(line, start)},
+			End:   lspPos{lineno, lspChar(line, end)},
+		},
+		Severity: lspSeverityInfo,
+		Code:     "whole-script",
+		Source:   "uni",
+		Message:  fmt.Sprintf("%s word that looks like the Latin %q", scripts[0], skel),
+	}, true
+}
+
+// Maximum number of characters to offer code actions for in a selection.
+const lspMaxActions = 20
+
+// codeActions offers to replace every non-ASCII character in the range with an
+// escape or an ASCII equivalent, or remove them if they're invisible. For an
+// empty range this is the character at the cursor.
+func (s *lspServer) codeActions(uri string, rng lspRange, diags []lspDiagnostic) []lspCodeAction {
+	var (
+		doc     = s.docs[uri]
+		actions = make([]lspCodeAction, 0, 4)
+		n       int
+	)
+	action := func(title, kind string, preferred bool, cr lspRange, newText string) lspCodeAction {
+		a := lspCodeAction{Title: title, Kind: kind, IsPreferred: preferred}
+		a.Edit.Changes = map[string][]lspTextEdit{uri: {{Range: cr, NewText: newText}}}
+		for _, d := range diags {
+			if d.Range == cr && kind == "quickfix" {
+				a.Diagnostics = append(a.Diagnostics, d)
+			}
+		}
+		return a
+	}
+
+	for lineno := rng.Start.Line; lineno <= rng.End.Line && lineno < len(doc.lines); lineno++ {
+		var (
+			line     = doc.lines[lineno]
+			from, to = 0, len(line)
+		)
+		if lineno == rng.Start.Line {
+			from = lspOffset(line, rng.Start.Character)
+		}
+		if lineno == rng.End.Line {
+			to = lspOffset(line, rng.End.Character)
+		}
+		if rng.Start == rng.End && from < len(line) {
+			_, size := utf8.DecodeRuneInString(line[from:])
+			to = from + size
+		}
+
+		for i, r := range line[from:to] {
+			if r < 0x80 && r != 0x7f && (r >= 0x20 || r == '\t') {
+				continue
+			}
+			if n++; n > lspMaxActions {
+				return actions
+			}
+
+			var (
+				info, _ = unidata.Find(r)
+				cr      = lspRuneRange(lineno, line, from+i)
+				remove  = lspInvisible(info) || lspBidi(info)
+			)
+			if remove {
+				actions = append(actions, action("Remove "+lspName(info), "quickfix", true, cr, ""))
+			}
+			if a := info.ASCII(); a != "" && a != string(r) {
+				actions = append(actions, action(fmt.Sprintf("Replace %s with %q", lspName(info), a), "quickfix", !remove, cr, a))
+			}
+			esc := lspEscape(doc.lang, info)
+			actions = append(actions, action(fmt.Sprintf("Replace %s with %s", lspName(info), esc), "refactor.rewrite", false, cr, esc))
+		}
+	}
+	return actions
+}
+
+// lspEscape gets an escape for the character in the document's language.
+func lspEscape(lang string, info unidata.Codepoint) string {
+	r := info.Codepoint
+	switch lang {
+	case "go", "python", "c", "cpp", "csharp", "zig":
+		if r > 0xffff {
+			return fmt.Sprintf(`\U%08x`, r)
+		}
+		return fmt.Sprintf(`\u%04x`, r)
+	case "rust", "javascript", "javascriptreact", "typescript", "typescriptreact", "ruby", "php", "lua", "swift":
+		return fmt.Sprintf(`\u{%x}`, r)
+	case "perl":
+		return fmt.Sprintf(`\x{%x}`, r)
+	case "html", "markdown":
+		return info.HTML()
+	case "xml", "svg":
+		return info.XML()
+	case "css", "scss", "less":
+		return fmt.Sprintf(`\%06x`, r)
+	default:
+		return info.JSON()
+	}
+}
+
+// Maximum number of completion items.
+const lspMaxComplete = 100
+
+// complete completes :shortcode and \codepoint-name. The shortcodes are the
+// same as for "uni emojify", and codepoint names are lower-case with anything
+// that's not a letter or digit replaced with "_", so "EURO SIGN" is
+// \euro_sign.
+func (s *lspServer) complete(doc lspDoc, pos lspPos) any {
+	list := map[string]any{"isIncomplete": true, "items": []lspCompletionItem{}}
+	if pos.Line >= len(doc.lines) {
+		return list
+	}
+
+	var (
+		line  = doc.lines[pos.Line]
+		end   = lspOffset(line, pos.Character)
+		start = end
+	)
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(line[:start])
+		if !(r < 0x80 && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_')) {
+			break
+		}
+		start -= size
+	}
+	if start == 0 || end-start < 2 {
+		return list
+	}
+	trigger := line[start-1]
+	if trigger != ':' && trigger != '\\' {
+		return list
+	}
+	// Don't complete after a word, as in "key:value".
+	if start > 1 {
+		if r, _ := utf8.DecodeLastRuneInString(line[:start-1]); unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return list
+		}
+	}
+
+	var (
+		query = lspLabel(line[start:end])
+		rng   = lspRange{Start: lspPos{pos.Line, lspChar(line, start-1)}, End: pos}
+		items = make([]lspCompletionItem, 0, 16)
+	)
+	item := func(label, insert, detail string) lspCompletionItem {
+		label = string(trigger) + label
+		return lspCompletionItem{
+			Label:      label,
+			Kind:       1, // Text
+			Detail:     detail,
+			FilterText: label,
+			TextEdit:   lspTextEdit{Range: rng, NewText: insert},
+		}
+	}
+	// Prefer names starting with the query, and then shorter names.
+	order := func(a, b lspCompletionItem) int {
+		ap, bp := strings.HasPrefix(a.Label[1:], query), strings.HasPrefix(b.Label[1:], query)
+		if ap != bp {
+			if ap {
+				return -1
+			}
+			return 1
+		}
+		if len(a.Label) != len(b.Label) {
+			return len(a.Label) - len(b.Label)
+		}
+		return strings.Compare(a.Label, b.Label)
+	}
+
+	if trigger == ':' {
+		query = strings.ToLower(line[start:end])
+		for _, e := range unidata.Emojis {
+			for _, sc := range e.Shortcodes() {
+				if l := strings.Trim(sc, ":"); strings.Contains(strings.ToLower(l), query) {
+					items = append(items, item(l, e.String(), e.String()+" "+e.Name))
+				}
+			}
+		}
+	} else {
+		for _, info := range unidata.Codepoints {
+			if l := lspLabel(info.Name()); strings.Contains(l, query) {
+				items = append(items, item(l, string(info.Codepoint), info.Display()+" "+info.FormatCodepoint()))
+			}
+		}
+	}
+
+	slices.SortFunc(items, order)
+	if len(items) > lspMaxComplete {
+		items = items[:lspMaxComplete]
+	}
+	for i := range items {
+		items[i].SortText = fmt.Sprintf("%04d", i)
+	}
+	list["items"] = items
+	return list
+}
+
+// lspLabel lower-cases name and replaces everything that's not a letter or
+// digit with "_".
+func lspLabel(name string) string {
+	b := make([]byte, 0, len(name))
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < 0x80 && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b = append(b, byte(r))
+		case len(b) > 0 && b[len(b)-1] != '_':
+			b = append(b, '_')
+		}
+	}
+	return strings.TrimRight(string(b), "_")
+}
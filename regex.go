@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// The regular expression flavour for -as regex; set from the -flavor flag.
+var regexFlavor = "go"
+
+var regexFlavors = []string{"go", "pcre", "js", "python", "posix-bracket", "java"}
+
+// printRegex prints a character class that matches all codepoints, or an
+// alternation that matches all emojis.
+//
+// For JavaScript this is a regexp literal with the "u" flag, as \u{..} escapes
+// and ranges outside the BMP don't work without it.
+func (f *Format) printRegex(out io.Writer) {
+	var re string
+	if len(f.emojis) > 0 {
+		re = regexAlternation(f.emojis)
+	} else {
+		cps := make([]rune, 0, len(f.tblData))
+		for _, c := range f.tblData {
+			cps = append(cps, c.Codepoint)
+		}
+		re = regexClass(cps)
+	}
+	if regexFlavor == "js" {
+		re = "/" + re + "/u"
+	}
+	fmt.Fprintln(out, re)
+}
+
+// regexClass creates a character class for all codepoints, using ranges where
+// possible.
+func regexClass(cps []rune) string {
+	slices.Sort(cps)
+	cps = slices.Compact(cps)
+
+	// In POSIX bracket expressions "]" needs to be first, "-" last, "^"
+	// anywhere except first, and "[" can't be followed by ".", ":", or "=";
+	// there is no way to escape them.
+	var posix [4]bool
+	if regexFlavor == "posix-bracket" {
+		cps = slices.DeleteFunc(cps, func(r rune) bool {
+			i := strings.IndexRune("][^-", r)
+			if i > -1 {
+				posix[i] = true
+			}
+			return i > -1
+		})
+		if len(cps) == 0 && !posix[0] && !posix[1] && posix[2] && !posix[3] {
+			return `\^`
+		}
+	}
+
+	b := new(strings.Builder)
+	b.WriteByte('[')
+	if posix[0] {
+		b.WriteByte(']')
+	}
+	for i := 0; i < len(cps); i++ {
+		start := cps[i]
+		for i+1 < len(cps) && cps[i+1] == cps[i]+1 {
+			i++
+		}
+		switch end := cps[i]; {
+		case end == start:
+			b.WriteString(regexEscape(start))
+		case end == start+1:
+			b.WriteString(regexEscape(start) + regexEscape(end))
+		default:
+			b.WriteString(regexEscape(start) + "-" + regexEscape(end))
+		}
+	}
+	for i, c := range "][^-" {
+		if i > 0 && posix[i] {
+			b.WriteRune(c)
+		}
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// regexAlternation creates an alternation for all strings. Longer strings are
+// first, so that the full emoji sequence is matched rather than just the first
+// codepoint.
+func regexAlternation(strs []string) string {
+	strs = slices.Clone(strs)
+	slices.SortFunc(strs, func(a, b string) int {
+		if n := utf8.RuneCountInString(b) - utf8.RuneCountInString(a); n != 0 {
+			return n
+		}
+		return strings.Compare(a, b)
+	})
+	strs = slices.Compact(strs)
+
+	b := new(strings.Builder)
+	if regexFlavor == "posix-bracket" {
+		b.WriteByte('(')
+	} else {
+		b.WriteString("(?:")
+	}
+	for i, s := range strs {
+		if i > 0 {
+			b.WriteByte('|')
+		}
+		for _, r := range s {
+			if regexFlavor == "posix-bracket" && strings.ContainsRune(`\.[]()*+?{}|^$`, r) {
+				b.WriteByte('\\')
+			}
+			b.WriteString(regexEscape(r))
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// regexEscape escapes a codepoint for the current flavour. ASCII letters and
+// digits are always written as-is. POSIX doesn't have any escapes, so
+// everything is written as-is.
+func regexEscape(r rune) string {
+	if (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+		return string(r)
+	}
+	switch regexFlavor {
+	default: // go, pcre, java
+		return fmt.Sprintf(`\x{%04X}`, r)
+	case "posix-bracket":
+		return string(r)
+	case "js":
+		if r > 0xffff {
+			return fmt.Sprintf(`\u{%X}`, r)
+		}
+		return fmt.Sprintf(`\u%04X`, r)
+	case "python":
+		if r > 0xffff {
+			return fmt.Sprintf(`\U%08X`, r)
+		}
+		return fmt.Sprintf(`\u%04X`, r)
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"zgo.at/zli"
+)
+
+// Commands available with "uni serve", as /api/[command] and in the web UI.
+var serveCmds = map[string]func(out io.Writer, args []string, format string, as printAs, q url.Values) error{
+	"identify": func(out io.Writer, args []string, format string, as printAs, q url.Values) error {
+		return identify(out, args, format, q.Has("raw"), as)
+	},
+	"search": func(out io.Writer, args []string, format string, as printAs, q url.Values) error {
+		sortBy, err := serveSort(q)
+		if err != nil {
+			return err
+		}
+		return search(out, args, format, q.Has("raw"), as, q.Has("or"), sortBy)
+	},
+	"print": func(out io.Writer, args []string, format string, as printAs, q url.Values) error {
+		sortBy, err := serveSort(q)
+		if err != nil {
+			return err
+		}
+		return print(out, args, format, q.Has("raw"), as, sortBy)
+	},
+	"emoji": func(out io.Writer, args []string, format string, as printAs, q url.Values) error {
+		tones, err := parseToneFlag(q.Get("tone"))
+		if err != nil {
+			return err
+		}
+		gender := "person"
+		if q.Has("gender") {
+			gender = q.Get("gender")
+		}
+		genders, err := parseGenderFlag(gender)
+		if err != nil {
+			return err
+		}
+		return emoji(out, args, format, q.Has("raw"), as, q.Has("or"), tones, genders)
+	},
+	"list": func(out io.Writer, args []string, format string, as printAs, q url.Values) error {
+		return list(out, args, format, as, q.Get("order"))
+	},
+}
+
+func serveSort(q url.Values) (string, error) {
+	if !q.Has("sort") {
+		return "cpoint", nil
+	}
+	sortBy, err := match(q.Get("sort"), "cpoint", "name", "name-collated")
+	if err != nil {
+		return "", fmt.Errorf("sort: %w", err)
+	}
+	return sortBy, nil
+}
+
+// Commands in the order they're shown in the web UI.
+var serveOrder = []string{"identify", "search", "print", "emoji", "list"}
+
+// server is "uni serve".
+type server struct {
+	conf uniConfig
+
+	// The commands use global state such as the collator, which isn't safe
+	// for concurrent use, so run one command at a time.
+	mu sync.Mutex
+}
+
+// serve runs "uni serve", which serves a JSON API on /api/[command] and a web
+// UI on /.
+func serve(addr string, conf uniConfig) error {
+	isTerm = false // Never colourize the JSON or trim to the terminal width.
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           newServer(conf),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	fmt.Fprintf(zli.Stderr, "uni: listening on http://%s\n", addr)
+	return srv.ListenAndServe()
+}
+
+func newServer(conf uniConfig) http.Handler {
+	s := &server{conf: conf}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.ui)
+	for name := range serveCmds {
+		mux.HandleFunc("/api/"+name, s.api(name))
+	}
+	return mux
+}
+
+// run a command for a request; the query parameters are the same as the
+// flags, and "q" is the query (which can be given more than once).
+func (s *server) run(out io.Writer, cmd string, q url.Values, as printAs) error {
+	var args []string
+	if cmd == "identify" {
+		if v := strings.Join(q["q"], ""); v != "" {
+			args = []string{v}
+		}
+	} else {
+		for _, v := range q["q"] {
+			args = append(args, strings.Fields(v)...)
+		}
+	}
+	if len(args) == 0 {
+		return errors.New("need a query in the q parameter")
+	}
+
+	formatSet := q.Get("format") != ""
+	format := q.Get("format")
+	switch {
+	case formatSet:
+		var err error
+		format, err = s.conf.preset(format)
+		if err != nil {
+			return err
+		}
+	case cmd == "emoji":
+		format = defaultEmojiFormat
+	default:
+		format = defaultFormat
+	}
+	format = expandFormat(cmd, q.Get("format"), format, formatSet, cmd == "emoji")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return serveCmds[cmd](out, args, format, as, q)
+}
+
+// api serves /api/[command], which writes the same JSON as "-as json".
+//
+// No matches is an empty array rather than an error; errors are written as
+// {"error": "message"} with status 400.
+func (s *server) api(cmd string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		q := r.URL.Query()
+		as := printAsJSON
+		switch q.Get("as") {
+		case "", "json":
+		case "json-typed":
+			as = printAsJSONTyped
+		default:
+			serveError(w, fmt.Errorf("as: must be json or json-typed, not %q", q.Get("as")))
+			return
+		}
+		if q.Has("compact") {
+			as++
+		}
+
+		buf := new(bytes.Buffer)
+		err := s.run(buf, cmd, q, as)
+		if err != nil && err != errNoMatches {
+			serveError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if err == errNoMatches {
+			io.WriteString(w, "[]\n")
+			return
+		}
+		w.Write(buf.Bytes())
+	}
+}
+
+func serveError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// ui serves the web UI; codepoints are shown in the same grid as "-as table"
+// by default, and everything else as a table with the -format columns.
+func (s *server) ui(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	q := r.URL.Query()
+	data := struct {
+		Style          template.CSS
+		Cmds           []string
+		Cmd, Query     string
+		View, Error    string
+		Result         template.HTML
+		HasQuery, Grid bool
+	}{
+		Style:    template.CSS(htmlStyle),
+		Cmds:     serveOrder,
+		Cmd:      q.Get("cmd"),
+		Query:    strings.Join(q["q"], " "),
+		View:     q.Get("view"),
+		HasQuery: q.Get("q") != "",
+	}
+	if _, ok := serveCmds[data.Cmd]; !ok {
+		data.Cmd = "identify"
+	}
+	data.Grid = data.Cmd != "emoji" && data.Cmd != "list"
+
+	if data.HasQuery {
+		as := printAsHTMLCompact
+		if data.Grid && data.View != "list" {
+			as = printAsHTMLTableCompact
+		}
+		buf := new(bytes.Buffer)
+		err := s.run(buf, data.Cmd, q, as)
+		switch {
+		case err == errNoMatches:
+			data.Error = "No matches."
+		case err != nil:
+			data.Error = err.Error()
+		default:
+			data.Result = template.HTML(buf.String())
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := serveTpl.Execute(w, data)
+	if err != nil {
+		fmt.Fprintf(zli.Stderr, "uni: serve: %s\n", err)
+	}
+}
+
+var serveTpl = template.Must(template.New("").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>uni</title>
+<style>
+{{.Style}}
+	form                  { margin-bottom: 1em; }
+	input[name=q]         { width: 30em; }
+	.error                { color: #cd0000; }
+</style>
+</head>
+<body>
+<form>
+	<select name="cmd">
+		{{- range .Cmds}}
+		<option{{if eq . $.Cmd}} selected{{end}}>{{.}}</option>
+		{{- end}}
+	</select>
+	<input name="q" value="{{.Query}}" autofocus>
+	<select name="view">
+		<option value="table">table</option>
+		<option value="list"{{if eq .View "list"}} selected{{end}}>list</option>
+	</select>
+	<button>Go</button>
+</form>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{.Result}}
+</body>
+</html>
+`))
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"zgo.at/uni/v2/unidata"
+)
+
+// The template from -template or -template-file.
+var outputTemplate *template.Template
+
+// Keys in the column maps to pass data to the template, -as regex, and -copy;
+// these are never valid column names.
+const (
+	tmplCodepoint = "\x00codepoint"
+	tmplRaw       = "\x00raw"
+	tmplEmoji     = "\x00emoji"
+	tmplCopy      = "\x00copy"
+)
+
+var templateFuncs = template.FuncMap{
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"join":    func(sep string, l []string) string { return strings.Join(l, sep) },
+	"pad":     func(n int, s any) string { return leftAlign(fmt.Sprint(s), n) },
+	"padLeft": func(n int, s any) string { return rightAlign(fmt.Sprint(s), n) },
+}
+
+// parseTemplate parses the template from the -template flag or the
+// -template-file flag.
+func parseTemplate(tpl, file string) (*template.Template, error) {
+	if file != "" {
+		if tpl != "" {
+			return nil, fmt.Errorf("can't use both -template and -template-file")
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("-template-file flag: %w", err)
+		}
+		tpl = string(b)
+	}
+	t, err := template.New("uni").Funcs(templateFuncs).Parse(tpl)
+	if err != nil {
+		if file != "" {
+			return nil, fmt.Errorf("-template-file flag: %w", err)
+		}
+		return nil, fmt.Errorf("-template flag: %w", err)
+	}
+	return t, nil
+}
+
+// templateData gets the data to pass to the template for a line: a
+// templateCodepoint, templateEmoji, or just the columns for commands that
+// print something else.
+func templateData(columns map[string]string) any {
+	if cp, ok := columns[tmplCodepoint]; ok {
+		r, _ := utf8.DecodeRuneInString(cp)
+		info, ok := unidata.Find(r)
+		if !ok {
+			info = unidata.Codepoint{Codepoint: r}
+		}
+		return templateCodepoint{info: info, raw: columns[tmplRaw] != "", Columns: columns}
+	}
+	if _, ok := columns[tmplEmoji]; ok {
+		split := func(s, sep string) []string {
+			if s == "" {
+				return []string{}
+			}
+			return strings.Split(s, sep)
+		}
+		return templateEmoji{
+			Emoji:     columns["emoji"],
+			Name:      columns["name"],
+			Group:     columns["group"],
+			Subgroup:  columns["subgroup"],
+			Tab:       columns["tab"],
+			CLDR:      split(columns["cldr"], ", "),
+			CLDRFull:  split(columns["cldr_full"], ", "),
+			CPoint:    split(columns["cpoint"], " "),
+			Shortcode: split(columns["shortcode"], ", "),
+			Columns:   columns,
+		}
+	}
+	return columns
+}
+
+// templateCodepoint is the data for -template for codepoints; there is a
+// method for every column.
+type templateCodepoint struct {
+	info unidata.Codepoint
+	raw  bool
+
+	// All columns as strings, including the command-specific ones.
+	Columns map[string]string
+}
+
+func (t templateCodepoint) Char() string {
+	if t.raw {
+		return string(t.info.Codepoint)
+	}
+	return t.info.Display()
+}
+
+func (t templateCodepoint) WidePadding() string { return widePadding(t.info) }
+func (t templateCodepoint) CPoint() string      { return t.info.FormatCodepoint() }
+func (t templateCodepoint) Dec() int            { return int(t.info.Codepoint) }
+func (t templateCodepoint) Hex() string         { return t.info.Format(16) }
+func (t templateCodepoint) Oct() string         { return t.info.Format(8) }
+func (t templateCodepoint) Bin() string         { return t.info.Format(2) }
+func (t templateCodepoint) UTF8() string        { return fmt.Sprintf("% x", t.info.UTF8()) }
+func (t templateCodepoint) UTF16BE() string     { return fmt.Sprintf("% x", t.info.UTF16(true)) }
+func (t templateCodepoint) UTF16LE() string     { return fmt.Sprintf("% x", t.info.UTF16(false)) }
+func (t templateCodepoint) HTML() string        { return t.info.HTML() }
+func (t templateCodepoint) XML() string         { return t.info.XML() }
+func (t templateCodepoint) JSON() string        { return t.info.JSON() }
+func (t templateCodepoint) KeySym() string      { return t.info.KeySym() }
+func (t templateCodepoint) Digraph() string     { return t.info.Digraph() }
+func (t templateCodepoint) Name() string        { return t.info.Name() }
+func (t templateCodepoint) Cat() string         { return t.info.Category().String() }
+func (t templateCodepoint) Block() string       { return t.info.Block().String() }
+func (t templateCodepoint) Plane() string       { return t.info.Plane().String() }
+func (t templateCodepoint) Width() string       { return t.info.Width().String() }
+func (t templateCodepoint) Cells() int          { return t.info.CellsWith(widthModel) }
+func (t templateCodepoint) Script() string      { return t.info.Script().String() }
+func (t templateCodepoint) Unicode() string     { return t.info.Unicode().String() }
+func (t templateCodepoint) Aliases() []string   { return t.info.Aliases() }
+func (t templateCodepoint) Refs() []string      { return t.info.Refs() }
+func (t templateCodepoint) IDStatus() string    { return t.info.IDStatus().String() }
+func (t templateCodepoint) IDNA() string        { return idnaStatus(t.info) }
+func (t templateCodepoint) IDNAMapping() string { return idnaMapping(t.info) }
+func (t templateCodepoint) SortKey() string     { return sortKey(t.info) }
+func (t templateCodepoint) ASCII() string       { return t.info.ASCII() }
+func (t templateCodepoint) InFont() string      { return inFont(t.info) }
+func (t templateCodepoint) Compose() []string   { return composeList(t.info) }
+func (t templateCodepoint) LaTeX() []string     { return t.info.LaTeX() }
+
+func (t templateCodepoint) Props() []string {
+	p := t.info.Properties()
+	l := make([]string, 0, len(p))
+	for _, pp := range p {
+		l = append(l, pp.String())
+	}
+	return l
+}
+
+func (t templateCodepoint) IDType() []string {
+	p := t.info.IDType()
+	l := make([]string, 0, len(p))
+	for _, pp := range p {
+		l = append(l, pp.String())
+	}
+	return l
+}
+
+// templateEmoji is the data for -template for emojis.
+type templateEmoji struct {
+	Emoji     string
+	Name      string
+	Group     string
+	Subgroup  string
+	Tab       string
+	CLDR      []string // CLDR names, without words already in the name.
+	CLDRFull  []string
+	CPoint    []string
+	Shortcode []string
+
+	// All columns as strings, including the command-specific ones.
+	Columns map[string]string
+}
+
+// printTemplate prints every line with the template, adding a newline if the
+// output doesn't end with one. Nothing is printed if the output is empty, so
+// the template can skip lines.
+func (f *Format) printTemplate(out io.Writer) error {
+	buf := new(bytes.Buffer)
+	for _, l := range f.tmplLines {
+		buf.Reset()
+		err := outputTemplate.Execute(buf, templateData(l))
+		if err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			continue
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		out.Write(buf.Bytes())
+	}
+	return nil
+}
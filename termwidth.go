@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zgo.at/uni/v2/unidata"
+)
+
+// Widths of characters and sequences as measured in the current terminal, as
+// written by "termwidth -save"; these override the widths we'd otherwise
+// use.
+var widthOverrides map[string]int
+
+// widthFile gets the path to the width override file for the current
+// terminal; the terminal is identified by $TERM_PROGRAM, or $TERM if that's
+// not set.
+func widthFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	term := os.Getenv("TERM_PROGRAM")
+	if term == "" {
+		term = os.Getenv("TERM")
+	}
+	if term == "" {
+		term = "unknown"
+	}
+	return filepath.Join(dir, "uni", "widths", filepath.Base(term)), nil
+}
+
+// loadWidths loads the width override file. It's not an error if it doesn't
+// exist.
+//
+// Every line is a list of codepoints followed by the width:
+//
+//	U+1F600 2
+//	U+1F408 U+200D U+2B1B 2
+func loadWidths() (map[string]int, error) {
+	path, err := widthFile()
+	if err != nil {
+		return nil, nil
+	}
+	fp, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer fp.Close()
+
+	var (
+		widths = make(map[string]int)
+		scan   = bufio.NewScanner(fp)
+		lineno int
+	)
+	for scan.Scan() {
+		lineno++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		f := strings.Fields(line)
+		w, err := strconv.Atoi(f[len(f)-1])
+		if len(f) < 2 || err != nil {
+			return nil, fmt.Errorf("%s:%d: need codepoints followed by a width", path, lineno)
+		}
+		var s strings.Builder
+		for _, cp := range f[:len(f)-1] {
+			c, err := unidata.FromString(cp)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineno, err)
+			}
+			s.WriteRune(c.Codepoint)
+		}
+		widths[s.String()] = w
+	}
+	return widths, scan.Err()
+}
+
+// saveWidths writes the width override file.
+func saveWidths(widths map[string]int) (string, error) {
+	path, err := widthFile()
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(widths))
+	for k := range widths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, "# Width overrides for %s, written by \"uni termwidth -save\".\n", filepath.Base(path))
+	for _, k := range keys {
+		for _, c := range k {
+			fmt.Fprintf(b, "U+%04X ", c)
+		}
+		fmt.Fprintf(b, "%d\n", widths[k])
+	}
+	return path, os.WriteFile(path, b.Bytes(), 0o644)
+}
+
+// probeWidth writes s at the start of the line and gets the cursor position
+// with DSR (Device Status Report), which the terminal reports as
+// "ESC[row;colR". The line is cleared afterwards.
+func probeWidth(tty io.ReadWriter, s string) (int, error) {
+	_, err := fmt.Fprintf(tty, "\r%s\x1b[6n", s)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		resp = make([]byte, 0, 16)
+		b    = make([]byte, 1)
+	)
+	for {
+		_, err := tty.Read(b)
+		if err != nil {
+			return 0, fmt.Errorf("reading cursor position: %w", err)
+		}
+		resp = append(resp, b[0])
+		if b[0] == 'R' {
+			break
+		}
+		if len(resp) > 64 {
+			return 0, fmt.Errorf("unexpected response to cursor position request: %q", resp)
+		}
+	}
+	fmt.Fprint(tty, "\r\x1b[K")
+
+	// There may be other input before the response; e.g. if someone pressed
+	// a key.
+	i := bytes.LastIndex(resp, []byte("\x1b["))
+	if i == -1 {
+		return 0, fmt.Errorf("unexpected response to cursor position request: %q", resp)
+	}
+	_, col, _ := strings.Cut(string(resp[i+2:len(resp)-1]), ";")
+	n, err := strconv.Atoi(col)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected response to cursor position request: %q", resp)
+	}
+	return n - 1, nil
+}
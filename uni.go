@@ -4,16 +4,24 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	xidna "golang.org/x/net/idna"
+	"golang.org/x/term"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 	"zgo.at/uni/v2/unidata"
 	"zgo.at/zli"
 	"zgo.at/zstd/zmap"
@@ -32,11 +40,14 @@ uni queries the unicode database. https://github.com/arp242/uni
 
 Flags:
     -f, -format    Output format.
-    -a, -as        How to print the results: list (default), json, or table.
+    -a, -as        How to print the results: list (default), json,
+                   json-typed, table, csv, tsv, markdown, html, html-table,
+                   template, or regex.
     -c, -compact   More compact output.
     -r, -raw       Don't use graphical variants or add combining characters.
     -p, -pager     Output to $PAGER.
     -o, -or        Use "or" when searching instead of "and".
+    -s, -sort      Sort search and print results: cpoint, name, name-collated.
 
 Commands:
     list           List Unicode data such as blocks, categories, etc.
@@ -44,6 +55,22 @@ Commands:
     search         Search description for any of the words.
     print          Print characters by codepoint, category, or block.
     emoji          Search emojis.
+    emojify        Replace shortcodes such as :tada: with emojis.
+    demojify       Replace emojis with shortcodes.
+    restriction    Get the UTS #39 restriction level of identifiers.
+    idna           Convert or check internationalized domain names.
+    sort           Sort lines with the Unicode Collation Algorithm.
+    translit       Transliterate text to ASCII, a slug, ISO 9, or pinyin.
+    style          Style text as bold, italic, circled, etc.
+    latex          Convert LaTeX math notation to Unicode text.
+    font           Show which characters a font covers.
+    termwidth      Measure the width of characters in the terminal.
+    codegen        Generate a lookup table for Go, C, Rust, or Python.
+    config         Show the settings from the config file.
+    completion     Print a shell completion script for bash, zsh, or fish.
+    serve          Serve a JSON API and web UI over HTTP.
+    lsp            Run a language server for editors.
+    xcompose       Generate an .XCompose file from the digraphs.
 
 Use "%(prog) help" or "%(prog) -h" for a more detailed help.
 `)
@@ -60,34 +87,139 @@ Flags:
     -f, -format    Columns to print and their formatting; see Format section
                    below for details.
 
-    -a, -as        How to print the results: list (default), json, or table.
+    -a, -as        How to print the results: list (default), json,
+                   json-typed, table, csv, tsv, markdown, html, html-table,
+                   template, or regex.
 
                      json    The columns listed in -format are included,
                              ignoring formatting flags. Use "-format all" to
-                             include all columns.
+                             include all columns. All values are strings.
+                     json-typed
+                             Like json, but numbers are written as numbers
+                             and lists as arrays. The JSON Schema is in
+                             uni.schema.json in the source repository.
                      table   Output as table; instead of listing the codepoints
                              on every line. This ignores the -format flag.
+                     csv     The columns listed in -format as CSV (RFC 4180),
+                             ignoring formatting flags, with a header row.
+                     tsv     Like csv, but separated by tabs.
+                     markdown
+                             The columns listed in -format as a GitHub-flavoured
+                             Markdown table, ignoring formatting flags other
+                             than right-alignment. "md" is an alias.
+                     html    Like markdown, but as a HTML page.
+                     html-table
+                             Like table, but as a HTML page.
+                     template
+                             Use the template from -template or
+                             -template-file; see Templates section below.
+                     regex   A regular expression character class that
+                             matches all codepoints, or an alternation that
+                             matches all emojis; see -flavor.
+
+    -template      Go text/template to print every codepoint or emoji with;
+                   implies "-as template".
+    -template-file Read the template from a file.
+
+    -flavor        Regular expression flavour for -as regex: go (default),
+                   pcre, js, python, posix-bracket, or java. For js this is
+                   a regexp literal with the "u" flag (/[..]/u), which is
+                   needed for codepoints outside the BMP. Use
+                   "uni emoji -as regex -tone all -gender all all" to match
+                   all emojis.
 
     -c, -compact   More compact output; don't print header, "no matches", etc.
-                   For json output it uses minified output, and for table it
-                   has less padding.
+                   For json output it uses minified output, for table it has
+                   less padding, for csv and tsv it omits the header row, for
+                   markdown it doesn't align the columns, and for html and
+                   html-table it prints only the <table> and not a full page.
 
     -r, -raw       Don't use graphical variants for control characters and
                    don't add ◌ (U+25CC) before combining characters.
 
     -p, -pager     Output to $PAGER.
 
+    -copy          Copy the characters (for identify, print, and search) or
+                   emojis (for emoji) to the clipboard, in addition to printing
+                   them. The character itself is copied, even without -raw.
+                   This uses wl-copy or xclip if $WAYLAND_DISPLAY or $DISPLAY
+                   is set. Over SSH, in tmux, or if neither is found it uses
+                   the OSC 52 terminal escape sequence, if your terminal
+                   supports it; inside tmux this needs "set -g
+                   allow-passthrough on".
+    -copy-col      Column to copy instead of the character or emoji, for
+                   example "-copy-col cpoint"; implies -copy. Every value is
+                   on its own line.
+
     -o, -or        Use "or" when searching: match if at least one parameter
                    matches, instead of only when all parameters match.
 
+    -s, -sort      How to sort the results of search and print:
+
+                     cpoint          By codepoint (default).
+                     name            By name, in byte order.
+                     name-collated   By name, using the Unicode Collation
+                                     Algorithm with the -locale and -strength
+                                     flags.
+
+    -l, -locale    Locale for collation, as a BCP 47 tag (e.g. "sv" or
+                   "de-u-co-phonebk"). The default is the root collation, which
+                   is the DUCET from Unicode 13.0; use "und-u-ka-shifted" to
+                   ignore spaces and punctuation. Other locales use Unicode 6.2
+                   and CLDR 23 data. Characters added later sort after
+                   everything else, by codepoint.
+
+    -strength      Collation strength: primary (base letters only), secondary
+                   (also accents), tertiary (also case; the default),
+                   quaternary (also punctuation, with "-locale
+                   und-u-ka-shifted"), or identical (also the codepoints).
+
+    -width-model   How to count the number of cells characters display as; used
+                   for %(cells), alignment, and tables:
+
+                     unicode          Wide and Fullwidth characters are 2
+                                      cells (default).
+                     ambiguous-wide   Ambiguous characters are also 2 cells,
+                                      as in CJK locales.
+                     glibc-wcwidth    What glibc's wcwidth() returns; this is
+                                      -1 for control characters and unassigned
+                                      codepoints.
+                     emoji-narrow     Emojis are 1 cell, as in terminals with
+                                      width tables from before Unicode 9.
+
     -q, -quiet     Backwards-compatible alias for -c/-compact.
     -j, -json      Backwards-compatible alias for -as json
+    -json-typed    Alias for -as json-typed
 
 Commands:
     list [query]     Show an overview of blocks, categories, scripts,
-                     properties, planes, or unicode versions. Every name can be
-                     abbreviated (i.e. "b" for "block"). Use "all" to show
-                     everything.
+                     properties, planes, unicode versions, emoji-groups, or
+                     emoji-subgroups. Every name can be abbreviated (i.e. "b"
+                     for "block"). Use "all" to show everything.
+
+                     Every list has its own columns for -format; use
+                     "-format all" to show all of them:
+
+                       blocks           from to range size assigned age plane
+                                        name
+                       categories       short name assigned age composed-of
+                       scripts          code name from assigned age
+                       properties       name assigned age
+                       planes           from to range size assigned age name
+                       unicode          version released assigned total
+                       emoji-groups     name subgroups emojis
+                       emoji-subgroups  group name emojis
+
+                     "code" is the ISO 15924 code, "assigned" the number of
+                     assigned codepoints, "age" the earliest Unicode version of
+                     these codepoints, and "total" the number of codepoints
+                     assigned in this and earlier Unicode versions.
+
+                     -order sets the order: name, start (first codepoint),
+                     assigned, or age. By default blocks and planes are
+                     ordered by start, unicode by age, emoji groups in the
+                     order of the Unicode emoji data, and everything else by
+                     name.
 
     identify [text]  Identify all the characters in the given arguments.
 
@@ -126,6 +258,30 @@ Commands:
 
                        Property    Prefix with "property:", "prop:", or "p:".
 
+                       Compose     Prefix with "compose:" to find characters
+                                   with an X11 Compose sequence starting with
+                                   these keys, either as the characters to type
+                                   or as in a Compose file:
+
+                                     compose:e=
+                                     'compose:<Multi_key> <e> <equal>'
+
+                       LaTeX       Prefix with "latex:" to find the character
+                                   for a LaTeX or unicode-math name:
+
+                                     latex:\alpha
+                                     'latex:\mathbb{R}'
+
+                       HTML        An HTML entity, as '&rarr;' or html:rarr.
+                                   Entities for more than one codepoint print
+                                   all of them: '&NotEqualTilde;'
+
+                       Keysym      Prefix with "keysym:" to find the character
+                                   for an X11 keysym: keysym:EuroSign
+
+                       Digraph     Prefix with "digraph:" to find the character
+                                   for an RFC 1345 digraph: digraph:Eu
+
                        all         All codepoints we know about.
 
                     The category, block, and property can be abbreviated, and
@@ -154,6 +310,7 @@ Commands:
                          group: g:    Group and subgroup
                          name:  n:    Emoji name
                          cldr:  c:    CLDR data
+                         shortcode:   Shortcode, as :name: or shortcode:name
 
                      The query parameters are AND'd together, so this:
 
@@ -187,6 +344,264 @@ Commands:
                      in terminals. It's recommended to copy to the clipboard
                      directly by piping to e.g. xclip.
 
+    restriction [string]
+                     Get the restriction level of every argument, or every line
+                     from stdin, as described in UTS #39 section 5.2. From least
+                     to most permissive:
+
+                         ASCII-Only              Only ASCII identifier
+                                                 characters.
+                         Single Script           All characters are from one
+                                                 script; Han is combined with
+                                                 Hiragana and Katakana, Hangul,
+                                                 or Bopomofo.
+                         Highly Restrictive      Latin combined with Han and
+                                                 Hiragana and Katakana, Han and
+                                                 Hangul, or Han and Bopomofo.
+                         Moderately Restrictive  Latin and one other recommended
+                                                 script, except Cyrillic and
+                                                 Greek.
+                         Minimally Restrictive   Any combination of allowed
+                                                 characters.
+                         Unrestricted            Contains characters that aren't
+                                                 allowed in identifiers.
+
+                     The scripts column lists the scripts that led to the
+                     restriction level; this takes Script_Extensions in to
+                     account.
+
+                     The identifier data is from Unicode 14.0; characters
+                     added after that are always Restricted.
+
+    idna [toascii|tounicode|check] [domain]
+                     Process internationalized domain names with UTS #46, for
+                     every argument or every line from stdin.
+
+                         toascii     Convert to ASCII ("Punycode"):
+                                     Bücher.example → xn--bcher-kva.example
+                         tounicode   Convert to Unicode:
+                                     xn--bcher-kva.example → bücher.example
+                         check       Check if the domain is valid for
+                                     registration; this is stricter than
+                                     toascii and tounicode, which map
+                                     characters (e.g. uppercase to lowercase)
+                                     as is done for lookups.
+
+                     This includes the bidi rules (RFC 5893) and the
+                     contextual rules for joiners (RFC 5892).
+
+                     Every codepoint is listed with its IDNA status (valid,
+                     mapped, deviation, ignored, or disallowed), using the
+                     regular -format flag. For tounicode the codepoints of the
+                     converted domain are listed. Use -c to print only the
+                     converted domain (or error), which is useful in scripts.
+                     The exit code is 1 if any domain is invalid.
+
+                     The conversion and the statuses both use the Unicode 15.0
+                     data from golang.org/x/net/idna.
+
+    sort [string]    Sort every argument, or every line from stdin, with the
+                     Unicode Collation Algorithm, using the -locale and
+                     -strength flags. Use "-f '%(sortkey) %(string)'" to show
+                     the sort keys. See -locale for the Unicode version.
+
+    translit [string]
+                     Transliterate every argument, or every line from stdin.
+                     The -scheme flag sets how:
+
+                         ascii    ASCII approximation (default). Text is
+                                  decomposed and accents are removed, and
+                                  Cyrillic, Greek, Arabic, Hebrew, Hangul, kana,
+                                  and Han (as Mandarin) are romanized.
+                         slug     Like ascii, but lower-case and everything
+                                  that's not a letter or digit is replaced
+                                  with "-"; for URLs and filenames.
+                         iso9     Cyrillic with ISO 9:1995.
+                         pinyin   Han as pinyin, with tone marks.
+
+                     Use -v or -verbose to print a table of what every
+                     character was converted to.
+
+    style [text]     Style text with the -as flag, using the Mathematical
+                     Alphanumeric Symbols, Enclosed Alphanumerics, etc:
+
+                         bold, italic, bold-italic, script, bold-script,
+                         fraktur, bold-fraktur, double-struck, sans, sans-bold,
+                         sans-italic, sans-bold-italic, monospace, circled,
+                         fullwidth, smallcaps, superscript, subscript,
+                         upside-down
+
+                     Characters without a styled variant are kept as-is; for
+                     example there is no superscript "q".
+
+                     Use -plain instead of -as to convert styled text back to
+                     plain text; upside-down text isn't converted.
+
+    emojify [text]   Replace shortcodes with emojis, and demojify [text] to do
+    demojify [text]  the reverse:
+
+                         % %(prog) emojify ':tada: and :+1::skin-tone-3:'
+                         🎉 and 👍🏼
+                         % %(prog) demojify '🎉 and 👍🏼'
+                         :tada: and :+1::skin-tone-3:
+
+                     The shortcodes from gemoji are supported, as well as the
+                     CLDR name with underscores (:party_popper:). Skin tones
+                     can be added as :+1::skin-tone-3: (2 to 6) or
+                     :thumbsup_tone2: (1 to 5). demojify uses the first
+                     shortcode from %(shortcode), and :skin-tone-N: for skin
+                     tones; emojis with two different skin tones are kept
+                     as-is. Unknown shortcodes are kept as-is.
+
+    latex [text]     Convert simple LaTeX math notation to Unicode text:
+
+                         % %(prog) latex '\alpha^2 + \beta_1 \leq \infty'
+                         α² + β₁ ≤ ∞
+
+                     Commands are replaced with the character for the LaTeX
+                     or unicode-math name as shown with %(latex); ^ and _ with
+                     superscript and subscript characters; \mathbb{R},
+                     \mathbf, \mathit, \mathcal, \mathfrak, \mathsf, and
+                     \mathtt with the Mathematical Alphanumeric Symbols; and
+                     accents such as \hat{x} with combining characters. Braces
+                     are removed, and everything else is kept as-is.
+
+                     If there is no superscript, subscript, or styled variant
+                     for a character (for example there is no subscript "q")
+                     it's kept as-is, with a warning: x_q and e^(iπ).
+
+                     The names are from go-latex's tex2unicode table (which
+                     comes from matplotlib) and the unicode-math names of the
+                     Mathematical Alphanumeric Symbols; not every name in
+                     unimathsymbols.txt is included.
+
+    font [file] [query]
+                     Show which characters a TrueType or OpenType font covers;
+                     .ttf, .otf, .ttc, .woff, and .woff2 files are supported.
+                     For collections (.ttc) the first font is used.
+
+                     The query is the same as for print; every codepoint is
+                     listed with the %(in_font) column, followed by a summary.
+                     With "-as table" missing glyphs are greyed out, the same
+                     as unassigned codepoints.
+
+                     Use emoji:query to check emojis, using the same query as
+                     the emoji command:
+
+                         uni font NotoColorEmoji.ttf emoji:g:flags
+
+                     Emojis are covered if all their codepoints are in the
+                     font; this doesn't check if sequences are combined in a
+                     single glyph.
+
+                     Without a query the coverage of all blocks the font has at
+                     least one codepoint for is shown.
+
+    codegen [query]  Generate source code with a lookup table for the
+                     codepoints selected by the query, which is the same as
+                     for print. Use -lang to set the language:
+
+                         go       A *unicode.RangeTable
+                         c        A sorted array of ranges, and a function
+                                  with a binary search
+                         rust     Same as C
+                         python   A frozenset for up to 256 codepoints, or
+                                  ranges and a function using bisect
+
+                     -name sets the name of the table or function; it's
+                     converted to snake_case for rust and python. The default
+                     is "inSet".
+
+                         uni codegen -lang c -name isGreek script:greek
+
+    termwidth [query]
+                     Measure how wide characters or emojis are in the current
+                     terminal, by writing them and asking the terminal for the
+                     cursor position. This lists everything where the measured
+                     width is different from the %(cells) prediction; use -v or
+                     -verbose to list everything.
+
+                     The query is the same as for print, or emoji:query for
+                     emojis (see the font command). The default is emoji:all.
+
+                     Use -save to write the measured widths to a width
+                     override file for this terminal, which is then used to
+                     align the output. The file is stored as
+                     uni/widths/$TERM_PROGRAM (or $TERM if that's not set) in
+                     the user's config directory, e.g.:
+
+                         ~/.config/uni/widths/WezTerm
+
+                     Every line has the codepoints followed by the width:
+
+                         U+1F408 U+200D U+2B1B 2
+
+                     Placeholders are the same as for print or emoji, with
+                     the addition of %(measured) and %(cells) for emojis.
+
+    config show [command]
+                     Show the settings from the config file. If a command is
+                     given, show the settings that will be used for it. See the
+                     Config file section below.
+
+    completion bash|zsh|fish
+                     Print a completion script for the shell. This completes
+                     commands, flags, flag values, and the names for queries
+                     such as block:, script:, and group:. To load it:
+
+                         bash   source <(%(prog) completion bash)
+                         zsh    source <(%(prog) completion zsh)
+                         fish   %(prog) completion fish | source
+
+    serve            Serve a JSON API and web UI over HTTP on the address from
+                     -listen (default localhost:8080). The API endpoints are:
+
+                         /api/identify  /api/search  /api/print
+                         /api/emoji     /api/list
+
+                     These write the same JSON as -as json. The query is in
+                     the "q" parameter, which can be given more than once, and
+                     the other parameters are the same as the flags without
+                     the "-": as (json or json-typed), compact, format, raw,
+                     or, sort, tone, gender, and order. For example:
+
+                         /api/print?q=U%2B2042..U%2B2050&format=all
+                         /api/emoji?q=thumbs+up&tone=dark&compact
+
+                     No matches is an empty array, and errors are written as
+                     {"error": "message"} with status 400.
+
+    lsp              Run a language server (LSP) on stdin and stdout, which
+                     provides:
+
+                       - hover with the -format all details of the character
+                         under the cursor;
+                       - warnings for invisible and bidirectional control
+                         characters, for words that mix scripts such as a
+                         Cyrillic "а" in a Latin word, and for words in
+                         another script that look like a Latin word, such as
+                         the Cyrillic "раураӏ";
+                       - code actions to remove invisible characters, or to
+                         replace a character with an escape or its ASCII
+                         equivalent;
+                       - completion of emoji shortcodes and \codepoint_name to
+                         the character, for example :thumbsup or \euro_sign.
+
+                     Escapes are in the syntax of the document's language if
+                     known, and a JSON escape otherwise.
+
+    xcompose [query] Generate an .XCompose file with sequences for characters
+                     that don't have an X11 Compose sequence in the default
+                     Compose file. The query is the same as for print; the
+                     default is all characters with a digraph.
+
+                     The sequence is the RFC 1345 digraph as shown with
+                     %(digraph), or the reverse if that conflicts with an
+                     existing sequence. Characters for which both conflict are
+                     written as a comment. For example:
+
+                         % %(prog) xcompose U+2200..U+2203 >~/.XCompose
+
 Format:
     You can use the -format or -f flag to control what to print; placeholders
     are in the form of %(name) or %(name flags), where "name" is a column name
@@ -203,6 +618,9 @@ Format:
     with json if you want to get all information uni knows about a codepoint
     or emoji.
 
+    A value starting with "@" uses a preset from the config file, for example
+    "-f @keysym"; see Config file section below.
+
     Flags:
         %(name l:5)     Left-align and pad with 5 spaces
         %(name l:auto)  Left-align and pad to the longest value
@@ -241,6 +659,10 @@ Format:
         %(json)          JSON escape                   \u2713
         %(keysym)        X11 keysym; can be blank      checkmark
         %(digraph)       Vim Digraph; can be blank     OK
+        %(compose)       X11 Compose sequences,        <Multi_key> <C> <equal>
+                         separated by ,; can be blank
+        %(latex)         LaTeX and unicode-math names, \checkmark
+                         separated by ,; can be blank
         %(name)          Code point name               CHECK MARK
         %(cat)           Category name                 Other_Symbol
         %(block)         Block name                    Dingbats
@@ -248,17 +670,55 @@ Format:
         %(plane)         Plane name                    Basic Multilingual Plane
         %(width)         Character width               Narrow
         %(cells)         Number of cells it display    1
-                         as, 0, 1, or 2
+                         as, 0, 1, or 2; see
+                         -width-model
         %(unicode)       First assigned in Unicode     1.1
         %(wide_padding)  Blank for wide characters,
                          space otherwise; for alignment
         %(aliases)       Alias names                   factorial, bang
         %(refs)          Reference other codepoints,   U+221A, U+1F5F8, U+1FBB1
                          usually similar/alternatives
+        %(id_status)     UTS #39 identifier status     Allowed
+        %(id_type)       UTS #39 identifier types,     Not_XID
+                         separated by spaces
+        %(idna)          UTS #46 IDNA status           mapped
+        %(idna_mapping)  IDNA mapping, if any          ss
+        %(sortkey)       UCA sort key with -locale     0b 4f 00 00 00 20
+                         and -strength                 00 00 00 02
+        %(ascii)         ASCII transliteration; can    Zh
+                         be blank
+        %(in_font)       If the codepoint is in the    yes
+                         font; font command only
 
         The default is:
         `+defaultFormat+`
 
+    Placeholders for idna; in addition to the above:
+        %(domain)        The domain as given           Bücher.example
+        %(result)        The converted domain          xn--bcher-kva.example
+        %(error)         Error, if any
+        %(label)         The label of this codepoint   Bücher
+
+        The default is:
+        `+defaultIDNAFormat+`
+
+    Placeholders for sort:
+        %(string)        The line
+        %(sortkey)       UCA sort key for the line
+
+        The default is %(string), without a header.
+
+    Placeholders for translit:
+        %(string)        The input string              Жук
+        %(result)        The transliterated string     Zhuk
+        %(char)          Character(s) for -v           Ж
+        %(cpoint)        Codepoints for -v             U+0416
+        %(translit)      Transliteration for -v        Zh
+        %(name)          Names for -v                  CYRILLIC CAPITAL LETTER ZHE
+
+        The default is %(result), without a header, or this with -v:
+        `+defaultTranslitVerbose+`
+
     Placeholders for emoji:
 
         %(emoji)       The emoji itself                🧑‍🚒
@@ -268,44 +728,194 @@ Format:
         %(cpoint)      Codepoints                      U+1F9D1 U+200D U+1F692
         %(cldr)        CLDR data, w/o emoji name       firetruck
         %(cldr_full)   Full CLDR data                  firefighter, firetruck
+        %(shortcode)   Shortcodes                      :firefighter:
+        %(in_font)     If it's in the font, for font   yes
+                       command with emoji:
 
         The default is:
         `+defaultEmojiFormat+`
+
+Templates:
+    The -template and -template-file flags print every line with a Go
+    text/template; see https://pkg.go.dev/text/template for the syntax. A
+    newline is added if the output doesn't end with one, and nothing is
+    printed if the output is empty.
+
+    For codepoints every column is available in CamelCase (.Char, .CPoint,
+    .UTF8, .IDNAMapping, .InFont, etc.), which are strings except for these:
+
+        .Dec .Cells                     Number
+        .Aliases .Refs .Props .IDType   List of strings
+        .Compose .LaTeX
+
+    For emojis there is .Emoji, .Name, .Group, .Subgroup, .Tab, .CLDR,
+    .CLDRFull, .CPoint, and .Shortcode; the last four are lists.
+
+    .Columns has the columns from -format, as well as any extra columns the
+    command adds (e.g. "measured" for termwidth). Other commands get just the
+    columns, e.g. {{.name}} for "uni list scripts".
+
+    Functions in addition to the text/template builtins:
+
+        upper s       Upper-case s
+        lower s       Lower-case s
+        join sep l    Join the list l with sep
+        pad n s       Left-align and pad s to n cells; s can also be a number
+        padLeft n s   Right-align and pad s to n cells
+
+    For example:
+
+        % uni s -template '{{.CPoint}}{{range .Aliases}} [{{.}}]{{end}}' factorial
+        U+0021 [factorial] [bang]
+
+        % uni p U+2190..U+2191 -template '{{printf "0x%04X" .Dec}}, /* {{lower .Name}} */'
+        0x2190, /* leftwards arrow */
+        0x2191, /* upwards arrow */
+
+Config file:
+    Defaults for flags are read from uni/config in the user's config directory
+    ($XDG_CONFIG_HOME, usually ~/.config). Set $UNI_CONFIG to use different
+    files, separated with ":" (";" on Windows); later files override earlier
+    ones. Set it to an empty string to not load any config file. Flags on the
+    commandline always override the config file.
+
+    Every line is "key = value"; values can be quoted with double quotes to
+    keep leading or trailing spaces, and lines starting with # are comments.
+    Keys at the start apply to all commands, and keys after a [command] line
+    apply to just that command. The keys are format, as, tone, gender, and
+    pager, for the -format, -as, -tone, -gender, and -pager flags.
+
+    Formats in the [preset] section can be used with "-f @name":
+
+        pager = true
+
+        [emoji]
+        tone   = medium
+        gender = woman
+
+        [print]
+        format = @review
+
+        [preset]
+        keysym = 0x%(hex l:auto f:0): %(keysym l:auto q:":",) // %name
+        review = %(char q l:3) %(cpoint l:7) %(script l:auto) %name
 `)
 
 const (
 	defaultFormat  = "%(char q h l:3)%(wide_padding) %(cpoint h l:7) %(dec l:6) %(utf8 l:11) %(html l:10) %(name t) %(aliases t h Q:[:])"
 	defaultCompact = "%(char q h l:3)%(wide_padding) %(cpoint h l:7) %(name t l:auto)"
 	allFormat      = "%(char q h l:3)%(wide_padding) %(cpoint h l:auto) %width %cells %dec %hex %oct %bin" +
-		" %utf8 %utf16le %utf16be %html %xml %json %keysym %digraph %name %plane %cat %block" +
-		" %script %props %unicode %aliases %refs"
+		" %utf8 %utf16le %utf16be %html %xml %json %keysym %digraph %compose %latex %name %plane %cat %block" +
+		" %script %props %unicode %aliases %refs %id_status %id_type %idna %idna_mapping %sortkey %ascii %in_font"
+
+	defaultIDNAFormat      = "%(label l:auto)  %(char q h l:3)%(wide_padding) %(cpoint h l:7) %(idna l:auto) %(idna_mapping Q l:auto) %(name t)"
+	defaultTranslitVerbose = "%(char q h l:auto)  %(cpoint h l:auto)  %(translit q l:auto)  %(name t)"
+	defaultIDNAJSON        = "%(domain) %(result) %(error) %(label) %(char) %(cpoint) %(idna) %(idna_mapping) %(name)"
+
+	defaultFontFormat      = "%(char q h l:3)%(wide_padding) %(cpoint h l:7) %(in_font l:auto)  %(name t)"
+	defaultFontEmojiFormat = "%(emoji h)%(tab)%(in_font l:auto)  %name"
+	defaultTermwidthFormat = "%(char q h l:3)%(wide_padding) %(cpoint h l:7) %(cells r:auto) %(measured r:auto)  %(name t)"
+	defaultTermwidthEmoji  = "%(emoji h)%(tab)%(cpoint l:auto)  %(cells r:auto) %(measured r:auto)  %name"
 
 	defaultEmojiFormat  = "%(emoji h)%(tab)%name  %(cldr t Q:[:])"
 	defaultEmojiCompact = "%(emoji h)%(tab)%name"
-	allEmojiFormat      = "%(emoji h)%(tab)%name %group %subgroup %cpoint %cldr %(cldr_full)"
+	allEmojiFormat      = "%(emoji h)%(tab)%name %group %subgroup %cpoint %cldr %(cldr_full) %shortcode"
 )
 
+// Export the JavaScript API instead of running a command; set when building
+// for js/wasm.
+var jsAPI func()
+
 func main() {
+	// Run as a library from JavaScript if there are no arguments.
+	if jsAPI != nil && len(os.Args) < 2 {
+		jsAPI()
+		return
+	}
+
+	// Hidden command for the shell completion scripts; this needs to run
+	// before flags are parsed, as the arguments may be incomplete flags.
+	if len(os.Args) > 1 && os.Args[1] == "__complete" {
+		complete(zli.Stdout, os.Args[2:])
+		return
+	}
+
 	flag := zli.NewFlags(os.Args)
 	var (
-		compact  = flag.Bool(false, "c", "compact", "q", "quiet")
-		help     = flag.Bool(false, "h", "help")
-		versionF = flag.Bool(false, "v", "version")
-		rawF     = flag.Bool(false, "r", "raw")
-		pager    = flag.Bool(false, "p", "pager")
-		or       = flag.Bool(false, "o", "or")
-		formatF  = flag.String(defaultFormat, "format", "f")
-		tone     = flag.String("", "t", "tone", "tones")
-		gender   = flag.String("person", "g", "gender", "genders")
-		asF      = flag.String("list", "a", "as")
-		jsonF    = flag.Bool(false, "json", "j")
+		compact   = flag.Bool(false, "c", "compact", "q", "quiet")
+		help      = flag.Bool(false, "h", "help")
+		versionF  = flag.Bool(false, "v", "version")
+		rawF      = flag.Bool(false, "r", "raw")
+		pager     = flag.Bool(false, "p", "pager")
+		or        = flag.Bool(false, "o", "or")
+		formatF   = flag.String(defaultFormat, "format", "f")
+		tone      = flag.String("", "t", "tone", "tones")
+		gender    = flag.String("person", "g", "gender", "genders")
+		asF       = flag.String("list", "a", "as")
+		jsonF     = flag.Bool(false, "json", "j")
+		jsonTyped = flag.Bool(false, "json-typed")
+		sortF     = flag.String("cpoint", "s", "sort")
+		locale    = flag.String("", "l", "locale")
+		strength  = flag.String("tertiary", "strength")
+		scheme    = flag.String("ascii", "scheme")
+		verboseF  = flag.Bool(false, "verbose")
+		plain     = flag.Bool(false, "plain")
+		save      = flag.Bool(false, "save")
+		widthM    = flag.String("unicode", "width-model")
+		tmplF     = flag.String("", "template")
+		tmplFile  = flag.String("", "template-file")
+		flavor    = flag.String("go", "flavor")
+		lang      = flag.String("go", "lang")
+		nameF     = flag.String("inSet", "name")
+		orderF    = flag.String("", "order")
+		copyF     = flag.Bool(false, "copy")
+		copyColF  = flag.String("", "copy-col")
+		listen    = flag.String("localhost:8080", "listen")
 	)
 	zli.F(flag.Parse())
-	if versionF.Set() {
+
+	cmd, err := flag.ShiftCommand("list", "ls", "l", "identify", "i", "id", "print", "search", "s", "se", "emoji", "e", "em", "emo", "emoj", "emojify", "demojify",
+		"restriction", "idna", "sort", "translit", "style", "latex", "font", "termwidth", "codegen", "config", "completion", "serve", "lsp", "xcompose", "help", "version")
+	switch cmd {
+	case "ls", "l": // Alias because I keep typing "ls"; "l" was always "list".
+		cmd = "list"
+	case "i", "id": // "i" and "id" are ambiguous with "idna", but were always "identify".
+		cmd = "identify"
+	case "s", "se": // Same for "sort" and "serve".
+		cmd = "search"
+	case "e", "em", "emo", "emoj": // And "emojify" and "demojify".
+		cmd = "emoji"
+	}
+
+	// -v is -verbose for translit and termwidth.
+	if versionF.Set() && cmd != "translit" && cmd != "termwidth" {
 		fmt.Println(version)
 		return
 	}
-	if pager.Set() {
+
+	// Use defaults from the config file for flags not given on the commandline.
+	conf, err2 := loadConfig()
+	zli.F(err2)
+	formatSet, asSet, usePager := formatF.Set(), asF.Set(), pager.Set()
+	if v, ok := conf.get(cmd, "format"); ok && !formatSet {
+		*formatF.Pointer(), formatSet = v, true
+	}
+	if v, ok := conf.get(cmd, "as"); ok && !asSet && !jsonF.Set() && !jsonTyped.Set() {
+		*asF.Pointer(), asSet = v, true
+	}
+	if v, ok := conf.get(cmd, "tone"); ok && !tone.Set() {
+		*tone.Pointer() = v
+	}
+	if v, ok := conf.get(cmd, "gender"); ok && !gender.Set() {
+		*gender.Pointer() = v
+	}
+	if v, ok := conf.get(cmd, "pager"); ok && !usePager {
+		usePager, _ = strconv.ParseBool(v)
+	}
+	*formatF.Pointer(), err2 = conf.preset(formatF.String())
+	zli.F(err2)
+
+	if usePager {
 		defer zli.PagerStdout()()
 	}
 	if help.Set() {
@@ -313,10 +923,6 @@ func main() {
 		return
 	}
 
-	cmd, err := flag.ShiftCommand("list", "ls", "identify", "print", "search", "emoji", "help", "version")
-	if cmd == "ls" { // Alias because I keep typing "ls"
-		cmd = "list"
-	}
 	switch cmd {
 	case "":
 		if errors.As(err, &zli.ErrCommandNoneGiven{}) {
@@ -333,57 +939,188 @@ func main() {
 		u := unidata.Unicodes[unidata.UnicodeLatest]
 		fmt.Printf("%s; Unicode %s (%s)\n", version, u.Name, u.Released)
 		return
+	case "config":
+		zli.F(configCmd(conf, flag.Args))
+		return
+	case "completion":
+		zli.F(completion(zli.Stdout, flag.Args))
+		return
 	}
 
 	var (
-		as    = parseAsFlags(compact, asF, jsonF)
+		as    printAs
 		quiet = compact.Set()
 		raw   = rawF.Set()
 		args  = flag.Args
 	)
-	if cmd == "print" {
+	// -as is the text style for the style command.
+	if cmd != "style" {
+		as = parseAsFlags(compact, asF, jsonF, jsonTyped)
+	}
+	if tmplF.Set() || tmplFile.Set() {
+		if asF.Set() && as != printAsTemplate && as != printAsTemplateCompact {
+			zli.Fatalf("can't use -template or -template-file with -as %s", asF.String())
+		}
+		as = printAsTemplate
+		outputTemplate, err = parseTemplate(tmplF.String(), tmplFile.String())
+		zli.F(err)
+	} else if as == printAsTemplate || as == printAsTemplateCompact {
+		zli.Fatalf("-as template needs -template or -template-file")
+	}
+	if copyF.Set() || copyColF.Set() {
+		switch cmd {
+		case "identify", "print", "search":
+			copyCol = "char"
+		case "emoji":
+			copyCol = "emoji"
+		default:
+			zli.Fatalf("-copy only works with identify, print, search, and emoji")
+		}
+		if copyColF.Set() {
+			copyCol = copyColF.String()
+		}
+	}
+	regexFlavor, err = match(flavor.String(), regexFlavors...)
+	if err != nil {
+		zli.Fatalf("-flavor flag: %s", err)
+	}
+	sortBy, err := match(sortF.String(), "cpoint", "name", "name-collated")
+	if err != nil {
+		zli.Fatalf("-sort flag: %s", err)
+	}
+	collator, err = newCollator(locale.String(), strength.String())
+	zli.F(err)
+	if cmd == "print" || cmd == "font" || cmd == "codegen" {
 		args, err = zli.InputOrArgs(args, " \t\n", quiet)
 		zli.F(err)
-	} else if cmd != "list" {
+	} else if cmd == "restriction" || cmd == "idna" || cmd == "sort" || cmd == "translit" {
+		args, err = zli.InputOrArgs(args, "\n", quiet)
+		zli.F(err)
+	} else if cmd != "list" && cmd != "termwidth" && cmd != "serve" && cmd != "lsp" && cmd != "xcompose" {
 		args, err = zli.InputOrArgs(args, "", quiet)
 		zli.F(err)
 	}
 
+	if cmd == "termwidth" && len(args) == 0 {
+		args = []string{"emoji:all"}
+	}
+	widthOverrides, err = loadWidths()
+	zli.F(err)
+	var ok bool
+	widthModel, ok = unidata.FindWidthModel(widthM.String())
+	if !ok {
+		zli.Fatalf("-width-model flag: unknown or ambiguous width model: %q", widthM.String())
+	}
+
 	format := formatF.String()
-	if !formatF.Set() && cmd == "emoji" {
+	if !formatSet && cmd == "emoji" {
 		format = defaultEmojiFormat
 	}
+	if !formatSet && cmd == "sort" {
+		// Don't print a header by default, so it works like sort(1).
+		format = "%(string)"
+		if as == printAsList {
+			as = printAsListCompact
+		}
+	}
+	verbose := (cmd == "translit" || cmd == "termwidth") && (versionF.Set() || verboseF.Set())
+	if !formatSet && cmd == "translit" {
+		switch {
+		case verbose:
+			format = defaultTranslitVerbose
+		case as.json():
+			format = "%(string) %(result)"
+		default:
+			format = "%(result)"
+			if as == printAsList {
+				as = printAsListCompact
+			}
+		}
+	}
+	if !formatSet && cmd == "idna" {
+		format = defaultIDNAFormat
+		if as.json() {
+			format = defaultIDNAJSON
+		}
+	}
 
-	if formatF.String() == "all" {
-		format = allFormat
-		if cmd == "emoji" {
-			format = allEmojiFormat
+	// Emoji queries for the font and termwidth commands use the emoji formats.
+	emojiFmt := cmd == "emoji" || (cmd == "font" && len(args) > 1 && emojiQuery(args[1:])) ||
+		(cmd == "termwidth" && emojiQuery(args))
+	if !formatSet && cmd == "termwidth" {
+		format = defaultTermwidthFormat
+		if emojiFmt {
+			format = defaultTermwidthEmoji
 		}
 	}
-	if strings.HasPrefix(formatF.String(), "+") {
-		format = defaultCompact
-		if cmd == "emoji" {
-			format = defaultEmojiCompact
+	if !formatSet && cmd == "font" {
+		format = defaultFontFormat
+		if emojiFmt {
+			format = defaultFontEmojiFormat
 		}
-		format += " " + formatF.String()[1:]
 	}
-	// Replace %name shortcut with %(name l:auto)
-	format = regexp.MustCompile(`%[a-z0-9-]+`).ReplaceAllStringFunc(format, func(s string) string {
-		return "%(" + s[1:] + " l:auto)"
-	})
+
+	format = expandFormat(cmd, formatF.String(), format, formatSet, emojiFmt)
+
+	var tones, genders unidata.EmojiModifier
+	if cmd == "emoji" || cmd == "font" || cmd == "termwidth" {
+		tones, err = parseToneFlag(tone.String())
+		zli.F(err)
+		genders, err = parseGenderFlag(gender.String())
+		zli.F(err)
+	}
 
 	switch cmd {
 	case "list":
-		err = list(args, as)
+		err = list(zli.Stdout, args, format, as, orderF.String())
 	case "identify":
-		err = identify(args, format, raw, as)
+		err = identify(zli.Stdout, args, format, raw, as)
 	case "search":
-		err = search(args, format, raw, as, or.Bool())
+		err = search(zli.Stdout, args, format, raw, as, or.Bool(), sortBy)
 	case "print":
-		err = print(args, format, raw, as)
+		err = print(zli.Stdout, args, format, raw, as, sortBy)
 	case "emoji":
-		err = emoji(args, format, raw, as, or.Bool(),
-			parseToneFlag(tone.String()), parseGenderFlag(gender.String()))
+		err = emoji(zli.Stdout, args, format, raw, as, or.Bool(), tones, genders)
+	case "restriction":
+		err = restriction(args, as)
+	case "idna":
+		err = idna(args, format, raw, as)
+	case "sort":
+		err = sortCmd(args, format, as)
+	case "translit":
+		err = translit(args, format, raw, as, scheme.String(), verbose)
+	case "font":
+		err = fontCmd(args, format, raw, as, or.Bool(), tones, genders)
+	case "termwidth":
+		err = termwidth(args, format, as, or.Bool(), tones, genders, verbose, save.Bool())
+	case "codegen":
+		err = codegen(zli.Stdout, args, lang.String(), nameF.String())
+	case "serve":
+		if len(args) > 0 {
+			err = errors.New("serve: doesn't accept arguments")
+			break
+		}
+		err = serve(listen.String(), conf)
+	case "xcompose":
+		err = xcompose(zli.Stdout, args)
+	case "lsp":
+		if len(args) > 0 {
+			err = errors.New("lsp: doesn't accept arguments")
+			break
+		}
+		err = lsp(zli.Stdin, zli.Stdout)
+	case "latex":
+		err = latex(zli.Stdout, args)
+	case "emojify":
+		err = emojify(zli.Stdout, args, false)
+	case "demojify":
+		err = emojify(zli.Stdout, args, true)
+	case "style":
+		if !asSet && !plain.Bool() {
+			err = errors.New("style: need -as or -plain")
+			break
+		}
+		err = style(args, asF.String(), plain.Bool())
 	}
 	if err != nil {
 		if !(err == errNoMatches && quiet) {
@@ -393,6 +1130,36 @@ func main() {
 	}
 }
 
+// expandFormat expands "all", "+", and the %name shortcut in the -format flag;
+// format is the format for the command if flagValue isn't set.
+func expandFormat(cmd, flagValue, format string, formatSet, emojiFmt bool) string {
+	// The list command has its own columns; it handles "all" and "+" itself.
+	if cmd == "list" {
+		format = ""
+		if formatSet {
+			format = flagValue
+		}
+	} else if flagValue == "all" {
+		format = allFormat
+		if emojiFmt {
+			format = allEmojiFormat
+		}
+	}
+	if cmd != "list" && strings.HasPrefix(flagValue, "+") {
+		format = defaultCompact
+		if emojiFmt {
+			format = defaultEmojiCompact
+		}
+		format += " " + flagValue[1:]
+	}
+	// Replace %name shortcut with %(name l:auto)
+	return reShortcut.ReplaceAllStringFunc(format, func(s string) string {
+		return "%(" + s[1:] + " l:auto)"
+	})
+}
+
+var reShortcut = regexp.MustCompile(`%[a-z0-9_-]+`)
+
 type fb interface {
 	Set() bool
 	Bool() bool
@@ -402,7 +1169,13 @@ type fs interface {
 	String() string
 }
 
-func parseAsFlags(compact fb, asF fs, jsonF fb) printAs {
+func parseAsFlags(compact fb, asF fs, jsonF, jsonTypedF fb) printAs {
+	if jsonTypedF.Set() {
+		if compact.Set() {
+			return printAsJSONTypedCompact
+		}
+		return printAsJSONTyped
+	}
 	if jsonF.Set() {
 		if compact.Set() {
 			return printAsJSONCompact
@@ -418,8 +1191,24 @@ func parseAsFlags(compact fb, asF fs, jsonF fb) printAs {
 		as = printAsList
 	case "j", "json":
 		as = printAsJSON
+	case "json-typed":
+		as = printAsJSONTyped
 	case "t", "tbl", "table":
 		as = printAsTable
+	case "csv":
+		as = printAsCSV
+	case "tsv":
+		as = printAsTSV
+	case "md", "markdown":
+		as = printAsMarkdown
+	case "html":
+		as = printAsHTML
+	case "html-table":
+		as = printAsHTMLTable
+	case "template":
+		as = printAsTemplate
+	case "regex", "regexp":
+		as = printAsRegex
 	}
 
 	if compact.Set() {
@@ -428,9 +1217,9 @@ func parseAsFlags(compact fb, asF fs, jsonF fb) printAs {
 	return as
 }
 
-func parseToneFlag(tone string) unidata.EmojiModifier {
+func parseToneFlag(tone string) (unidata.EmojiModifier, error) {
 	if tone == "" {
-		return 0
+		return 0, nil
 	}
 	if tone == "all" {
 		tone = "none,light,mediumlight,medium,mediumdark,dark"
@@ -452,15 +1241,15 @@ func parseToneFlag(tone string) unidata.EmojiModifier {
 		case "d", "dark":
 			m |= unidata.ModDark
 		default:
-			zli.Fatalf("invalid skin tone: %q", tone)
+			return 0, fmt.Errorf("invalid skin tone: %q", tone)
 		}
 	}
-	return m
+	return m, nil
 }
 
-func parseGenderFlag(gender string) unidata.EmojiModifier {
+func parseGenderFlag(gender string) (unidata.EmojiModifier, error) {
 	if gender == "" {
-		return 0
+		return 0, nil
 	}
 	if gender == "all" {
 		gender = "person,man,woman"
@@ -476,10 +1265,10 @@ func parseGenderFlag(gender string) unidata.EmojiModifier {
 		case "woman", "women", "w", "female", "f":
 			m |= unidata.ModFemale
 		default:
-			zli.Fatalf("invalid gender: %q", gender)
+			return 0, fmt.Errorf("invalid gender: %q", gender)
 		}
 	}
-	return m
+	return m, nil
 }
 
 // TODO: move to zli or zstd; this is a copy of ShiftCommand() basically.
@@ -515,208 +1304,56 @@ func match(input string, cmds ...string) (string, error) {
 	}
 }
 
-// TODO: this is essentially the same code repeated 3 times; this sucks.
-//
-// TODO: add -order flag; grouping by start codepoint isn't neccisarily all that
-// useful. Allow by name, too, and assigned, and maybe also grouping logically
-// (alphabets, symbols, CJK, control, etc.)
-func list(ls []string, as printAs) error {
-	if as == printAsTable || as == printAsTableCompact {
-		zli.Fatalf("can't use -as table with the list command")
+func list(out io.Writer, ls []string, format string, as printAs, order string) error {
+	if as.tbl() || as.regex() {
+		return fmt.Errorf("can't use -as %s with the list command", as)
 	}
 
 	if len(ls) == 0 {
-		return errors.New("need at least property to list: blocks, categories, scripts, properties, planes, unicode, or all")
+		return errors.New("need at least property to list: blocks, categories, scripts, properties, planes, unicode, emoji-groups, emoji-subgroups, or all")
+	}
+	if order != "" {
+		var err error
+		o, err := match(order, "name", "start", "assigned", "age")
+		if err != nil {
+			return fmt.Errorf("-order flag: unknown or ambiguous value %q; must be name, start, assigned, or age", order)
+		}
+		order = o
 	}
 	if slices.Contains(ls, "all") {
-		ls = []string{"blocks", "categories", "scripts", "properties", "planes", "unicode"}
+		ls = listNames
 	}
 
 	for i, l := range ls {
-		cmd, err := match(l, "blocks", "categories", "scripts", "properties", "unicode", "planes")
+		cmd, err := match(l, listNames...)
 		if cmd != "" && len(ls) > 0 && as == printAsList {
 			if i > 0 {
-				fmt.Fprintln(zli.Stdout)
+				fmt.Fprintln(out)
 			}
 			if len(ls) > 1 {
-				fmt.Fprintf(zli.Stdout, "%s:\n", zstring.UpperFirst(cmd))
+				fmt.Fprintf(out, "%s:\n", zstring.UpperFirst(strings.ReplaceAll(cmd, "-", " ")))
 			}
 		}
+		if cmd == "" {
+			return fmt.Errorf("list: %w", err)
+		}
 
-		switch cmd {
-		case "":
-			zli.Fatalf("list: %s", err)
-
-		case "unicode":
-			for _, k := range zmap.KeysOrdered(unidata.Unicodes)[1:] {
-				u := unidata.Unicodes[k]
-				fmt.Fprintf(zli.Stdout, "%-6s %s\n", u.Name, u.Released)
-			}
-
-		case "planes":
-			for _, k := range zmap.KeysOrdered(unidata.Planes) {
-				p := unidata.Planes[k]
-				s, e := fmt.Sprintf("U+%04X", p.Range[0]), fmt.Sprintf("U+%04X", p.Range[1])
-				fmt.Fprintf(zli.Stdout, "%-7s - %-8s  %s\n", s, e, p.Name)
-			}
-
-		case "blocks":
-			order := make([]struct {
-				Range [2]rune
-				Name  string
-			}, 0, len(unidata.Blocks))
-			for _, b := range unidata.Blocks {
-				order = append(order, b)
-			}
-			sort.Slice(order, func(i, j int) bool { return order[i].Range[0] < order[j].Range[0] })
-
-			assign := make(map[string]int)
-			for k := range unidata.Codepoints {
-				for _, b := range order {
-					if k >= b.Range[0] && k <= b.Range[1] {
-						assign[b.Name]++
-					}
-				}
-			}
-
-			f, err := NewFormat("%(from r:auto)  %(to r:auto)  %(assigned l:auto)  %(name l:auto)",
-				as, "from", "to", "assigned", "name")
-			zli.F(err)
-
-			fmtCp := map[bool]string{true: "%X", false: "% 7X"}[f.json()]
-			for _, b := range order {
-				f.Line(map[string]string{
-					"from":     fmt.Sprintf(fmtCp, b.Range[0]),
-					"to":       fmt.Sprintf(fmtCp, b.Range[1]),
-					"assigned": strconv.Itoa(assign[b.Name]),
-					"name":     b.Name,
-				})
-			}
-			f.Print(zli.Stdout)
-
-		case "scripts":
-			f, err := NewFormat("%(name l:auto)  %(assigned r:auto)",
-				as, "name", "assigned")
-			zli.F(err)
-
-			assign := make(map[unidata.Script]int)
-			order := make([]struct {
-				Name  string
-				Const unidata.Script
-			}, 0, len(unidata.Scripts))
-			for k, s := range unidata.Scripts {
-				if k == unidata.ScriptUnknown {
-					continue
-				}
-				order = append(order, struct {
-					Name  string
-					Const unidata.Script
-				}{s.Name, k})
-
-				for _, rng := range s.Ranges {
-					assign[k] += int(rng[1] - rng[0])
-				}
-			}
-			sort.Slice(order, func(i, j int) bool { return order[i].Const < order[j].Const })
-
-			for _, s := range order {
-				f.Line(map[string]string{
-					"name":     s.Name,
-					"assigned": strconv.Itoa(assign[s.Const]),
-				})
-			}
-			f.Print(zli.Stdout)
-
-		case "categories":
-			order := make([]struct {
-				ShortName, Name string
-				Include         []unidata.Category
-				Const           unidata.Category
-			}, 0, len(unidata.Categories))
-			for k, c := range unidata.Categories {
-				order = append(order, struct {
-					ShortName, Name string
-					Include         []unidata.Category
-					Const           unidata.Category
-				}{c.ShortName, c.Name, c.Include, k})
-			}
-			sort.Slice(order, func(i, j int) bool { return order[i].Const < order[j].Const })
-
-			assign := make(map[unidata.Category]int)
-			for _, cp := range unidata.Codepoints {
-				for _, c := range order {
-					if cp.Category() == c.Const {
-						assign[c.Const]++
-					}
-					for _, i := range c.Include {
-						if cp.Category() == i {
-							assign[c.Const]++
-						}
-					}
-				}
-			}
-
-			f, err := NewFormat("%(short l:auto)  %(name l:auto)  %(assigned r:auto)  %(composed-of l:auto)",
-				as, "short", "name", "assigned", "composed-of")
-			zli.F(err)
-
-			for _, b := range order {
-				comp := ""
-				if len(b.Include) > 0 {
-					var in []string
-					for _, i := range b.Include {
-						in = append(in, unidata.Categories[i].ShortName)
-					}
-					comp = strings.Join(in, " | ")
-				}
-
-				f.Line(map[string]string{
-					"short":       b.ShortName,
-					"name":        b.Name,
-					"assigned":    strconv.Itoa(assign[b.Const]),
-					"composed-of": comp,
-				})
-			}
-			f.Print(zli.Stdout)
-
-		case "properties":
-			order := make([]struct {
-				Name   string
-				Ranges [][2]rune
-			}, 0, len(unidata.Properties))
-			for _, p := range unidata.Properties {
-				order = append(order, p)
-			}
-			sort.Slice(order, func(i, j int) bool { return order[i].Name < order[j].Name })
-
-			assign := make(map[string]int)
-			for _, cp := range unidata.Codepoints {
-				for _, p := range cp.Properties() {
-					for _, c := range order {
-						if p.String() == c.Name {
-							assign[c.Name]++
-						}
-					}
-				}
-			}
-
-			f, err := NewFormat("%(name l:auto)  %(assigned r:auto)",
-				as, "name", "assigned")
-			zli.F(err)
-
-			for _, b := range order {
-				f.Line(map[string]string{
-					"assigned": strconv.Itoa(assign[b.Name]),
-					"name":     b.Name,
-				})
-			}
-			f.Print(zli.Stdout)
+		lt := listTypes[cmd]
+		f, err := NewFormat(lt.listFormat(format), as, lt.cols...)
+		if err != nil {
+			return err
 		}
+		entries := lt.entries(as)
+		orderList(entries, order)
+		for _, e := range entries {
+			f.Line(e.cols)
+		}
+		f.Print(out)
 	}
 	return nil
 }
 
-func identify(ins []string, format string, raw bool, as printAs) error {
+func identify(out io.Writer, ins []string, format string, raw bool, as printAs) error {
 	in := strings.Join(ins, "")
 	if !utf8.ValidString(in) {
 		fmt.Fprintf(zli.Stderr, "uni: WARNING: input string is not valid UTF-8\n")
@@ -735,11 +1372,11 @@ func identify(ins []string, format string, raw bool, as printAs) error {
 
 		f.Line(f.toLine(info, raw))
 	}
-	f.Print(zli.Stdout)
+	f.Print(out)
 	return nil
 }
 
-func search(args []string, format string, raw bool, as printAs, or bool) error {
+func search(out io.Writer, args []string, format string, raw bool, as printAs, or bool, sortBy string) error {
 	args = slices.DeleteFunc(args, func(s string) bool { return s == "" })
 	if len(args) == 0 {
 		return errors.New("search: need search term")
@@ -784,8 +1421,10 @@ func search(args []string, format string, raw bool, as printAs, or bool) error {
 	if !found {
 		return errNoMatches
 	}
-	f.SortNum("dec")
-	f.Print(zli.Stdout)
+	if err := sortLines(f, sortBy); err != nil {
+		return err
+	}
+	f.Print(out)
 	return nil
 }
 
@@ -801,12 +1440,76 @@ func nbools(bools ...bool) int {
 	return n
 }
 
-func print(args []string, format string, raw bool, as printAs) error {
+func print(out io.Writer, args []string, format string, raw bool, as printAs, sortBy string) error {
 	f, err := NewFormat(format, as, knownColumns...)
 	if err != nil {
 		return err
 	}
+	err = findCodepoints(out, args, as, func(info unidata.Codepoint) { f.Line(f.toLine(info, raw)) })
+	if err != nil {
+		return err
+	}
+	if err := sortLines(f, sortBy); err != nil {
+		return err
+	}
+	f.Print(out)
+	return nil
+}
+
+// findCodepoints finds all codepoints for the print query in args, calling
+// found for every codepoint.
+func findCodepoints(out io.Writer, args []string, as printAs, found func(unidata.Codepoint)) error {
 	for _, a := range args {
+		// Compose sequences, LaTeX names, HTML entities, keysyms, and digraphs
+		// are case-sensitive.
+		if len(a) >= 8 && strings.EqualFold(a[:8], "compose:") {
+			if err := findCompose(a[8:], found); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(a) >= 6 && strings.EqualFold(a[:6], "latex:") {
+			r, ok := unidata.FindLaTeX(a[6:])
+			if !ok {
+				return fmt.Errorf("unknown LaTeX name: %q", a[6:])
+			}
+			info, _ := unidata.Find(r)
+			found(info)
+			continue
+		}
+		if html, ok := strings.CutPrefix(a, "html:"); ok || (len(a) > 2 && a[0] == '&' && a[len(a)-1] == ';') {
+			if !ok {
+				html = a
+			}
+			text, ok := unidata.FindHTML(html)
+			if !ok {
+				return fmt.Errorf("unknown HTML entity: %q", html)
+			}
+			for _, r := range text {
+				info, _ := unidata.Find(r)
+				found(info)
+			}
+			continue
+		}
+		if len(a) >= 7 && strings.EqualFold(a[:7], "keysym:") {
+			r, ok := unidata.FindKeySym(a[7:])
+			if !ok {
+				return fmt.Errorf("unknown keysym: %q", a[7:])
+			}
+			info, _ := unidata.Find(r)
+			found(info)
+			continue
+		}
+		if len(a) >= 8 && strings.EqualFold(a[:8], "digraph:") {
+			r, ok := unidata.FindDigraph(a[8:])
+			if !ok {
+				return fmt.Errorf("unknown digraph: %q", a[8:])
+			}
+			info, _ := unidata.Find(r)
+			found(info)
+			continue
+		}
+
 		a = strings.Trim(strings.ToLower(a), ",/")
 		if a == "" {
 			continue
@@ -839,14 +1542,14 @@ func print(args []string, format string, raw bool, as printAs) error {
 				return fmt.Errorf("multiple characters in sequence %q", a)
 			}
 
-			f.Line(f.toLine(unidata.Codepoints[r], raw))
+			found(unidata.Codepoints[r])
 			continue
 		}
 
 		// Print everything.
 		if strings.ToLower(a) == "all" {
 			for _, info := range unidata.Codepoints {
-				f.Line(f.toLine(info, raw))
+				found(info)
 			}
 			continue
 		}
@@ -864,25 +1567,25 @@ func print(args []string, format string, raw bool, as printAs) error {
 			a = a[strings.IndexByte(a, ':')+1:]
 			bl, blOk = unidata.FindBlock(a)
 			if !blOk {
-				zli.Fatalf("unknown or ambiguous block: %q", a)
+				return fmt.Errorf("unknown or ambiguous block: %q", a)
 			}
 		case zstring.HasPrefixes(a, "script:", "s:"):
 			a = a[strings.IndexByte(a, ':')+1:]
 			sc, scOk = unidata.FindScript(a)
 			if !scOk {
-				zli.Fatalf("unknown or ambiguous script: %q", a)
+				return fmt.Errorf("unknown or ambiguous script: %q", a)
 			}
 		case zstring.HasPrefixes(a, "category:", "cat:"):
 			a = a[strings.IndexByte(a, ':')+1:]
 			cat, catOk = unidata.FindCategory(a)
 			if !catOk {
-				zli.Fatalf("unknown or ambiguous category: %q", a)
+				return fmt.Errorf("unknown or ambiguous category: %q", a)
 			}
 		case zstring.HasPrefixes(a, "property:", "prop:", "p:"):
 			a = a[strings.IndexByte(a, ':')+1:]
 			p, pOk = unidata.FindProperty(a)
 			if !pOk {
-				zli.Fatalf("unknown or ambiguous property: %q", a)
+				return fmt.Errorf("unknown or ambiguous property: %q", a)
 			}
 		default:
 			cat, catOk = unidata.FindCategory(a)
@@ -899,7 +1602,7 @@ func print(args []string, format string, raw bool, as printAs) error {
 				if pOk {
 					opt = append(opt, fmt.Sprintf("Property(%q)", p))
 				}
-				zli.Fatalf("%q matched multiple options:\n\t%s\nPrefix with 'block:', 'category:', or 'property:'",
+				return fmt.Errorf("%q matched multiple options:\n\t%s\nPrefix with 'block:', 'category:', or 'property:'",
 					a, strings.Join(opt, ", "))
 			}
 		}
@@ -908,16 +1611,16 @@ func print(args []string, format string, raw bool, as printAs) error {
 		if catOk {
 			cc := unidata.Categories[cat]
 			if as == printAsList || as == printAsTable {
-				fmt.Fprintf(zli.Stdout, "Showing category %s (%s)\n", cc.ShortName, cc.Name)
+				fmt.Fprintf(out, "Showing category %s (%s)\n", cc.ShortName, cc.Name)
 			}
 
 			for _, info := range unidata.Codepoints {
 				if info.Category() == cat {
-					f.Line(f.toLine(info, raw))
+					found(info)
 				}
 				for _, incl := range cc.Include {
 					if info.Category() == incl {
-						f.Line(f.toLine(info, raw))
+						found(info)
 					}
 				}
 			}
@@ -927,14 +1630,14 @@ func print(args []string, format string, raw bool, as printAs) error {
 		if scOk {
 			cc := unidata.Scripts[sc]
 			if as == printAsList || as == printAsTable {
-				fmt.Fprintf(zli.Stdout, "Showing script %s\n", cc.Name)
+				fmt.Fprintf(out, "Showing script %s\n", cc.Name)
 			}
 
 			for _, pp := range cc.Ranges {
 				for cp := pp[0]; cp <= pp[1]; cp++ {
 					s, ok := unidata.Codepoints[cp]
 					if ok {
-						f.Line(f.toLine(s, raw))
+						found(s)
 					}
 				}
 			}
@@ -945,13 +1648,13 @@ func print(args []string, format string, raw bool, as printAs) error {
 		// Block.
 		if blOk {
 			if as == printAsList || as == printAsTable {
-				fmt.Fprintf(zli.Stdout, "Showing block %s\n", bl)
+				fmt.Fprintf(out, "Showing block %s\n", bl)
 			}
 
 			for cp := unidata.Blocks[bl].Range[0]; cp <= unidata.Blocks[bl].Range[1]; cp++ {
 				s, ok := unidata.Codepoints[cp]
 				if ok {
-					f.Line(f.toLine(s, raw))
+					found(s)
 				}
 			}
 			continue
@@ -959,14 +1662,14 @@ func print(args []string, format string, raw bool, as printAs) error {
 		// Properties
 		if pOk {
 			if as == printAsList || as == printAsTable {
-				fmt.Fprintf(zli.Stdout, "Showing property %s\n", p)
+				fmt.Fprintf(out, "Showing property %s\n", p)
 			}
 
 			for _, pp := range unidata.Properties[p].Ranges {
 				for cp := pp[0]; cp <= pp[1]; cp++ {
 					s, ok := unidata.Codepoints[cp]
 					if ok {
-						f.Line(f.toLine(s, raw))
+						found(s)
 					}
 				}
 			}
@@ -994,21 +1697,19 @@ func print(args []string, format string, raw bool, as printAs) error {
 			return fmt.Errorf("invalid codepoint: %s", errors.Unwrap(err))
 		}
 		if start.Codepoint > end.Codepoint {
-			zli.Fatalf("end of range %q is lower than start %q", s[1], s[0])
+			return fmt.Errorf("end of range %q is lower than start %q", s[1], s[0])
 		}
 
 		for i := start.Codepoint; i <= end.Codepoint; i++ {
 			info, _ := unidata.Find(i)
-			f.Line(f.toLine(info, raw))
+			found(info)
 		}
 	}
-	f.SortNum("dec")
-	f.Print(zli.Stdout)
 	return nil
 }
 
-func emoji(args []string, format string, raw bool, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
-	if as == printAsTable || as == printAsTableCompact {
+func emoji(out io.Writer, args []string, format string, raw bool, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
+	if as.tbl() {
 		// TODO: it should
 		// The reason it doesn't work is because printTbl() assumes that every
 		// entry is a codepoint. Should instead duplicate some data in
@@ -1016,10 +1717,31 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 		return errors.New("-as table doesn't work with the emoji command")
 	}
 
+	found := findEmojis(args, or, tones, genders)
+	if len(found) == 0 {
+		return errNoMatches
+	}
+
+	f, err := NewFormat(format, as, "emoji", "name", "group", "subgroup",
+		"tab", "cldr", "cldr_full", "cpoint", "shortcode")
+	if err != nil {
+		return err
+	}
+	for _, e := range found {
+		f.ListLine(emojiLine(e), emojiLists(e))
+	}
+	f.Print(out)
+	return nil
+}
+
+// findEmojis finds all emojis matching the query in args, with the skin tone
+// and gender modifiers applied.
+func findEmojis(args []string, or bool, tones, genders unidata.EmojiModifier) []unidata.Emoji {
 	type matchArg struct {
-		group bool
-		name  bool
-		text  string
+		group     bool
+		name      bool
+		shortcode bool
+		text      string
 	}
 	var (
 		all       = slices.Contains(args, "all")
@@ -1036,7 +1758,12 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 			if name {
 				a = strings.TrimPrefix(strings.TrimPrefix(a, "name:"), "n:")
 			}
-			matchArgs = append(matchArgs, matchArg{text: a, group: group, name: name})
+			shortcode := strings.HasPrefix(a, "shortcode:") ||
+				(len(a) > 2 && strings.HasPrefix(a, ":") && strings.HasSuffix(a, ":"))
+			if shortcode {
+				a = ":" + strings.Trim(strings.TrimPrefix(a, "shortcode:"), ":") + ":"
+			}
+			matchArgs = append(matchArgs, matchArg{text: a, group: group, name: name, shortcode: shortcode})
 		}
 	}
 
@@ -1051,6 +1778,8 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 					strings.Contains(strings.ToLower(e.Subgroup().String()), a.text)
 			case a.name:
 				match = strings.Contains(strings.ToLower(e.Name), a.text)
+			case a.shortcode:
+				match = slices.Contains(e.Shortcodes(), a.text)
 			default:
 				match = strings.Contains(strings.ToLower(e.Name), a.text) ||
 					slices.Contains(e.CLDR, a.text)
@@ -1067,45 +1796,684 @@ func emoji(args []string, format string, raw bool, as printAs, or bool, tones, g
 			out = append(out, applyGenders(applyTones(e, tones), genders)...)
 		}
 	}
+	return out
+}
+
+func emojiLine(e unidata.Emoji) map[string]string {
+	return map[string]string{
+		"emoji":     e.String(),
+		"name":      e.Name,
+		"group":     e.Group().String(),
+		"subgroup":  e.Subgroup().String(),
+		"tab":       tabOrSpace(),
+		tmplEmoji:   "1",
+		"cldr":      strings.Join(e.Keywords(), ", "),
+		"cldr_full": strings.Join(e.CLDR, ", "),
+		"shortcode": strings.Join(e.Shortcodes(), ", "),
+		"cpoint":    strings.Join(emojiCodepoints(e), " "),
+	}
+}
+
+// emojiLists gets the values of the emoji columns that are lists.
+func emojiLists(e unidata.Emoji) map[string][]string {
+	return map[string][]string{
+		"cldr":      e.Keywords(),
+		"cldr_full": e.CLDR,
+		"shortcode": e.Shortcodes(),
+		"cpoint":    emojiCodepoints(e),
+	}
+}
+
+func emojiCodepoints(e unidata.Emoji) []string {
+	cp := make([]string, 0, len(e.Codepoints))
+	for _, c := range e.String() { // String() inserts ZWJ and whatnot
+		cp = append(cp, fmt.Sprintf("U+%04X", c))
+	}
+	return cp
+}
+
+// emojify replaces shortcodes with emojis, or emojis with shortcodes if
+// demojify is true.
+func emojify(out io.Writer, args []string, demojify bool) error {
+	text := strings.TrimRight(strings.Join(args, " "), "\n")
+	if demojify {
+		fmt.Fprintln(out, unidata.Demojify(text))
+	} else {
+		fmt.Fprintln(out, unidata.Emojify(text))
+	}
+	return nil
+}
+
+func restriction(args []string, as printAs) error {
+	if as.tbl() || as.regex() {
+		zli.Fatalf("can't use -as %s with the restriction command", as)
+	}
+	if len(args) == 0 {
+		return errors.New("restriction: need at least one string")
+	}
+
+	f, err := NewFormat("%(string q l:auto)  %(level l:auto)  %(scripts l:auto)",
+		as, "string", "level", "scripts")
+	zli.F(err)
+
+	for _, a := range args {
+		level, scripts := unidata.Restriction(a)
+		sc := make([]string, 0, len(scripts))
+		for _, s := range scripts {
+			sc = append(sc, s.String())
+		}
+		f.ListLine(map[string]string{
+			"string":  a,
+			"level":   level.String(),
+			"scripts": strings.Join(sc, ", "),
+		}, map[string][]string{"scripts": sc})
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+func idna(args []string, format string, raw bool, as printAs) error {
+	if as.tbl() || as.regex() {
+		zli.Fatalf("can't use -as %s with the idna command", as)
+	}
+	if len(args) == 0 {
+		return errors.New("idna: need a command: toascii, tounicode, or check")
+	}
+	cmd, err := match(args[0], "toascii", "tounicode", "check")
+	if err != nil {
+		return fmt.Errorf("idna: %w", err)
+	}
+	args = slices.DeleteFunc(args[1:], func(s string) bool { return s == "" })
+	if len(args) == 0 {
+		return errors.New("idna: need at least one domain")
+	}
+
+	// Print a table for every domain in list mode, and all of them at once
+	// for JSON.
+	var (
+		cols    = append(knownColumns, "domain", "result", "error", "label")
+		f       *Format
+		invalid bool
+	)
+	for i, a := range args {
+		var (
+			result string
+			err    error
+		)
+		switch cmd {
+		case "toascii":
+			result, err = xidna.Lookup.ToASCII(a)
+		case "tounicode":
+			result, err = xidna.Lookup.ToUnicode(a)
+		case "check":
+			_, err = xidna.Registration.ToASCII(a)
+			result = a
+		}
+		errS := ""
+		if err != nil {
+			invalid = true
+			errS = err.Error()
+		}
+
+		switch as {
+		case printAsListCompact:
+			if err != nil {
+				fmt.Fprintf(zli.Stdout, "%s: %s\n", a, errS)
+			} else {
+				fmt.Fprintln(zli.Stdout, result)
+			}
+			continue
+		case printAsList:
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			if err != nil {
+				fmt.Fprintf(zli.Stdout, "%s: %s\n", a, errS)
+			} else if cmd == "check" {
+				fmt.Fprintf(zli.Stdout, "%s: ok\n", a)
+			} else {
+				fmt.Fprintf(zli.Stdout, "%s → %s\n", a, result)
+			}
+		}
+		if f == nil || as == printAsList {
+			f, err = NewFormat(format, as, cols...)
+			if err != nil {
+				return err
+			}
+		}
+
+		show := a
+		if cmd == "tounicode" && errS == "" {
+			show = result
+		}
+		// The ideographic full stop and its variants are mapped to ".", so
+		// also treat them as separators.
+		labels := strings.FieldsFunc(show, func(r rune) bool {
+			return r == '.' || r == '\u3002' || r == '\uff0e' || r == '\uff61'
+		})
+		for _, l := range labels {
+			for _, c := range l {
+				info, ok := unidata.Find(c)
+				if !ok {
+					info = unidata.Codepoint{Codepoint: c}
+				}
+				line := f.toLine(info, raw)
+				line["domain"], line["result"], line["error"], line["label"] = a, result, errS, l
+				f.Line(line)
+			}
+		}
+		if as == printAsList {
+			f.Print(zli.Stdout)
+		}
+	}
+	if f != nil && as != printAsList {
+		f.Print(zli.Stdout)
+	}
+	if invalid {
+		return errors.New("idna: invalid domain")
+	}
+	return nil
+}
+
+// The collator to use for -sort name-collated, %(sortkey), and the sort
+// command; set from the -locale and -strength flags.
+var collator sortKeyer
+
+type sortKeyer interface {
+	Key(string) []byte
+}
+
+// tailoredCollator gets the sort keys from golang.org/x/text/collate, which
+// is used for locale tailorings.
+type tailoredCollator struct {
+	c   *collate.Collator
+	buf collate.Buffer
+}
+
+func (t *tailoredCollator) Key(s string) []byte {
+	defer t.buf.Reset()
+	return bytes.Clone(t.c.KeyFromString(&t.buf, s))
+}
+
+func newCollator(locale, strength string) (sortKeyer, error) {
+	tag := language.Und
+	if locale != "" {
+		var err error
+		tag, err = language.Parse(locale)
+		if err != nil {
+			return nil, fmt.Errorf("-locale flag: %w", err)
+		}
+	}
+
+	s, err := match(strength, "primary", "secondary", "tertiary", "quaternary", "identical")
+	if err != nil {
+		return nil, fmt.Errorf("-strength flag: %w", err)
+	}
+
+	// Use our own tables for the root collation, as x/text only has Unicode
+	// 6.2 and CLDR 23 data. The tailorings aren't in allkeys.txt, so those
+	// still need x/text.
+	root, _ := tag.SetTypeForKey("ka", "")
+	root, _ = root.SetTypeForKey("ks", "")
+	if root.String() == language.Und.String() {
+		c := unidata.Collator{Shifted: tag.TypeForKey("ka") == "shifted"}
+		for k, v := range unidata.CollationStrengths {
+			if v == s {
+				c.Strength = k
+			}
+		}
+		return c, nil
+	}
+
+	tag, err = tag.SetTypeForKey("ks", map[string]string{
+		"primary":    "level1",
+		"secondary":  "level2",
+		"tertiary":   "level3",
+		"quaternary": "level4",
+		"identical":  "identic",
+	}[s])
+	if err != nil {
+		return nil, fmt.Errorf("-strength flag: %w", err)
+	}
+	return &tailoredCollator{c: collate.New(tag)}, nil
+}
+
+func sortLines(f *Format, sortBy string) error {
+	switch sortBy {
+	case "name":
+		return f.SortFunc("name", func(a, b string) int { return strings.Compare(a, b) })
+	case "name-collated":
+		keys := make(map[string][]byte)
+		key := func(s string) []byte {
+			k, ok := keys[s]
+			if !ok {
+				k = collator.Key(s)
+				keys[s] = k
+			}
+			return k
+		}
+		return f.SortFunc("name", func(a, b string) int { return bytes.Compare(key(a), key(b)) })
+	default:
+		f.SortNum("dec")
+		return nil
+	}
+}
 
+func sortCmd(args []string, format string, as printAs) error {
+	if as.tbl() || as.regex() {
+		zli.Fatalf("can't use -as %s with the sort command", as)
+	}
+
+	f, err := NewFormat(format, as, "string", "sortkey")
+	if err != nil {
+		return err
+	}
+
+	keys := make([][]byte, len(args))
+	for i, a := range args {
+		keys[i] = collator.Key(a)
+	}
+	order := make([]int, len(args))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return bytes.Compare(keys[a], keys[b]) })
+	for _, i := range order {
+		f.Line(map[string]string{
+			"string":  args[i],
+			"sortkey": fmt.Sprintf("% x", keys[i]),
+		})
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+func translit(args []string, format string, raw bool, as printAs, scheme string, verbose bool) error {
+	if as.tbl() || as.regex() {
+		zli.Fatalf("can't use -as %s with the translit command", as)
+	}
+	if len(args) == 0 {
+		return errors.New("translit: need at least one string")
+	}
+
+	var (
+		sc    unidata.TranslitScheme
+		names = make([]string, 0, len(unidata.TranslitSchemes))
+	)
+	for _, n := range unidata.TranslitSchemes {
+		names = append(names, n)
+	}
+	m, err := match(scheme, names...)
+	if err != nil {
+		return fmt.Errorf("-scheme flag: %w", err)
+	}
+	for k, n := range unidata.TranslitSchemes {
+		if n == m {
+			sc = k
+		}
+	}
+
+	// Print a table for every string in list mode, and all of them at once
+	// otherwise.
+	var (
+		cols = []string{"string", "result", "char", "cpoint", "translit", "name"}
+		f    *Format
+	)
+	for i, a := range args {
+		result, parts := unidata.Transliterate(a, sc)
+		if f == nil || (verbose && as == printAsList) {
+			f, err = NewFormat(format, as, cols...)
+			if err != nil {
+				return err
+			}
+		}
+		if !verbose {
+			f.Line(map[string]string{"string": a, "result": result})
+			continue
+		}
+
+		if as == printAsList {
+			if i > 0 {
+				fmt.Fprintln(zli.Stdout)
+			}
+			fmt.Fprintf(zli.Stdout, "%s → %s\n", a, result)
+		}
+		for _, p := range parts {
+			var (
+				char = p.In
+				cp   = make([]string, 0, 1)
+				name = make([]string, 0, 1)
+			)
+			for _, r := range p.In {
+				info, _ := unidata.Find(r)
+				cp = append(cp, info.FormatCodepoint())
+				name = append(name, info.Name())
+				if !raw && len(cp) == 1 {
+					char = info.Display() + p.In[utf8.RuneLen(r):]
+				}
+			}
+			f.Line(map[string]string{
+				"string":   a,
+				"result":   result,
+				"char":     char,
+				"cpoint":   strings.Join(cp, " "),
+				"translit": p.Out,
+				"name":     strings.Join(name, ", "),
+			})
+		}
+		if as == printAsList {
+			f.Print(zli.Stdout)
+		}
+	}
+	if !verbose || as != printAsList {
+		f.Print(zli.Stdout)
+	}
+	return nil
+}
+
+func style(args []string, as string, plain bool) error {
+	text := strings.TrimRight(strings.Join(args, " "), "\n")
+	if plain {
+		fmt.Fprintln(zli.Stdout, unidata.Unstyle(text))
+		return nil
+	}
+
+	st, ok := unidata.FindStyle(as)
+	if !ok {
+		names := zmap.Values(unidata.Styles)
+		sort.Strings(names)
+		return fmt.Errorf("style: unknown style %q; known styles: %s", as, strings.Join(names, ", "))
+	}
+	fmt.Fprintln(zli.Stdout, unidata.Stylize(text, st))
+	return nil
+}
+
+func fontCmd(args []string, format string, raw bool, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
+	if len(args) == 0 || args[0] == "" {
+		return errors.New("font: need a font file")
+	}
+	var err error
+	fontCoverage, err = loadFont(args[0])
+	if err != nil {
+		return fmt.Errorf("font: %w", err)
+	}
+	name := filepath.Base(args[0])
+
+	args = slices.DeleteFunc(args[1:], func(s string) bool { return s == "" })
+	if len(args) == 0 {
+		return fontBlocks(name, as)
+	}
+	if emojiQuery(args) {
+		return fontEmoji(name, args, format, as, or, tones, genders)
+	}
+
+	f, err := NewFormat(format, as, knownColumns...)
+	if err != nil {
+		return err
+	}
+	var cps []unidata.Codepoint
+	err = findCodepoints(zli.Stdout, args, as, func(info unidata.Codepoint) { cps = append(cps, info) })
+	if err != nil {
+		return err
+	}
+	if len(cps) == 0 {
+		return errNoMatches
+	}
+	slices.SortFunc(cps, func(a, b unidata.Codepoint) int { return int(a.Codepoint - b.Codepoint) })
+
+	covered := 0
+	for _, c := range cps {
+		if fontCoverage[c.Codepoint] {
+			covered++
+		}
+		f.Line(f.toLine(c, raw))
+	}
+	f.Print(zli.Stdout)
+	if as == printAsList || as == printAsTable {
+		fmt.Fprintf(zli.Stdout, "\n%s covers %d of %d codepoints (%s)\n", name, covered, len(cps), percent(covered, len(cps)))
+	}
+	return nil
+}
+
+// emojiQuery reports if this is an emoji query for the font and termwidth
+// commands.
+func emojiQuery(args []string) bool {
+	return len(args) > 0 && strings.HasPrefix(strings.ToLower(args[0]), "emoji:")
+}
+
+// emojiArgs removes the "emoji:" prefix from all args.
+func emojiArgs(cmd string, args []string) ([]string, error) {
+	for i, a := range args {
+		if !emojiQuery(args[i:]) {
+			return nil, fmt.Errorf("%s: can't mix emoji: with other queries: %q", cmd, a)
+		}
+		args[i] = a[6:]
+	}
+	return args, nil
+}
+
+// An emoji is covered if the font has all the codepoints. This doesn't check
+// if the ZWJ sequences are actually combined in to one glyph.
+func fontEmoji(name string, args []string, format string, as printAs, or bool, tones, genders unidata.EmojiModifier) error {
+	if as.tbl() {
+		return errors.New("-as table doesn't work with emoji in the font command")
+	}
+	args, err := emojiArgs("font", args)
+	if err != nil {
+		return err
+	}
+
+	out := findEmojis(args, or, tones, genders)
 	if len(out) == 0 {
 		return errNoMatches
 	}
 
 	f, err := NewFormat(format, as, "emoji", "name", "group", "subgroup",
-		"tab", "cldr", "cldr_full", "cpoint")
+		"tab", "cldr", "cldr_full", "cpoint", "shortcode", "in_font")
 	if err != nil {
 		return err
 	}
+	covered := 0
 	for _, e := range out {
-		f.Line(map[string]string{
-			"emoji":    e.String(),
-			"name":     e.Name,
-			"group":    e.Group().String(),
-			"subgroup": e.Subgroup().String(),
-			"tab":      tabOrSpace(),
-			"cldr": func() string {
-				// Remove words that duplicate what's already in the name; it's
-				// kind of pointless.
-				cldr := make([]string, 0, len(e.CLDR))
-				for _, c := range e.CLDR {
-					if !strings.Contains(e.Name, c) {
-						cldr = append(cldr, c)
-					}
-				}
-				return strings.Join(cldr, ", ")
-			}(),
-			"cldr_full": strings.Join(e.CLDR, ", "),
-			"cpoint": func() string {
-				cp := make([]string, 0, len(e.Codepoints))
-				for _, c := range e.String() { // String() inserts ZWJ and whatnot
-					cp = append(cp, fmt.Sprintf("U+%04X", c))
+		has := true
+		for _, c := range e.String() {
+			if c != 0x200d && c != 0xfe0e && c != 0xfe0f && !fontCoverage[c] {
+				has = false
+				break
+			}
+		}
+		if has {
+			covered++
+		}
+		l := emojiLine(e)
+		l["in_font"] = map[bool]string{true: "yes", false: "no"}[has]
+		f.ListLine(l, emojiLists(e))
+	}
+	f.Print(zli.Stdout)
+	if as == printAsList {
+		fmt.Fprintf(zli.Stdout, "\n%s covers %d of %d emojis (%s)\n", name, covered, len(out), percent(covered, len(out)))
+	}
+	return nil
+}
+
+// List the coverage for every block the font has at least one codepoint for.
+func fontBlocks(name string, as printAs) error {
+	if as.tbl() || as.regex() {
+		return fmt.Errorf("font: -as %s needs a query", as)
+	}
+
+	order := make([]unidata.Block, 0, len(unidata.Blocks))
+	for b := range unidata.Blocks {
+		order = append(order, b)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return unidata.Blocks[order[i]].Range[0] < unidata.Blocks[order[j]].Range[0]
+	})
+
+	f, err := NewFormat("%(block l:auto)  %(covered r:auto)  %(assigned r:auto)  %(percent r:auto)",
+		as, "block", "covered", "assigned", "percent")
+	zli.F(err)
+
+	total := 0
+	for _, b := range order {
+		var covered, assigned int
+		for cp := unidata.Blocks[b].Range[0]; cp <= unidata.Blocks[b].Range[1]; cp++ {
+			if _, ok := unidata.Codepoints[cp]; ok {
+				assigned++
+				if fontCoverage[cp] {
+					covered++
 				}
-				return strings.Join(cp, " ")
-			}(),
+			}
+		}
+		if covered == 0 {
+			continue
+		}
+		total += covered
+		f.Line(map[string]string{
+			"block":    unidata.Blocks[b].Name,
+			"covered":  strconv.Itoa(covered),
+			"assigned": strconv.Itoa(assigned),
+			"percent":  percent(covered, assigned),
 		})
 	}
 	f.Print(zli.Stdout)
+	if as == printAsList {
+		fmt.Fprintf(zli.Stdout, "\n%s covers %d codepoints in %d blocks\n", name, total, len(f.lines)-1)
+	}
+	return nil
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "0%"
+	}
+	return strconv.Itoa(n*100/total) + "%"
+}
+
+func termwidth(args []string, format string, as printAs, or bool, tones, genders unidata.EmojiModifier, verbose, save bool) error {
+	if as.tbl() || as.regex() {
+		zli.Fatalf("can't use -as %s with the termwidth command", as)
+	}
+
+	// Collect the text to write, and the line to print with the predicted
+	// width.
+	type probe struct {
+		text  string
+		line  map[string]string
+		lists map[string][]string
+	}
+	var (
+		probes []probe
+		emoji  = emojiQuery(args)
+	)
+	if emoji {
+		args, err := emojiArgs("termwidth", args)
+		if err != nil {
+			return err
+		}
+		for _, e := range findEmojis(args, or, tones, genders) {
+			l := emojiLine(e)
+			l["cells"] = strconv.Itoa(modelWidth(e.String(), nil))
+			probes = append(probes, probe{text: e.String(), line: l, lists: emojiLists(e)})
+		}
+	} else {
+		f, err := NewFormat(format, as, append(knownColumns, "measured")...)
+		if err != nil {
+			return err
+		}
+		err = findCodepoints(zli.Stdout, args, as, func(info unidata.Codepoint) {
+			// Don't write anything that moves the cursor.
+			switch info.Category() {
+			case unidata.CatControl, unidata.CatSurrogate, unidata.CatLineSeparator, unidata.CatParagraphSeparator:
+				return
+			}
+			probes = append(probes, probe{text: string(info.Codepoint), line: f.toLine(info, true)})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(probes) == 0 {
+		return errNoMatches
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("termwidth: need a terminal: %w", err)
+	}
+	defer tty.Close()
+	// Use Control() rather than Fd(), as the latter sets it to blocking mode
+	// and we want a read timeout in case the terminal doesn't reply.
+	var st *term.State
+	conn, err := tty.SyscallConn()
+	if err == nil {
+		err2 := conn.Control(func(fd uintptr) { st, err = term.MakeRaw(int(fd)) })
+		if err2 != nil {
+			err = err2
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("termwidth: %w", err)
+	}
+	restore := func() { conn.Control(func(fd uintptr) { term.Restore(int(fd), st) }) }
+
+	measured := make([]int, len(probes))
+	for i, p := range probes {
+		tty.SetReadDeadline(time.Now().Add(2 * time.Second))
+		measured[i], err = probeWidth(tty, p.text)
+		if err != nil {
+			restore()
+			return fmt.Errorf("termwidth: %w", err)
+		}
+	}
+	restore()
+
+	cols := append(knownColumns, "measured")
+	if emoji {
+		cols = []string{"emoji", "name", "group", "subgroup", "tab", "cldr", "cldr_full", "cpoint", "shortcode", "cells", "measured"}
+	}
+	f, err := NewFormat(format, as, cols...)
+	if err != nil {
+		return err
+	}
+	var (
+		mismatch  int
+		overrides = make(map[string]int)
+	)
+	for k, v := range widthOverrides {
+		overrides[k] = v
+	}
+	for i, p := range probes {
+		predicted, _ := strconv.Atoi(p.line["cells"])
+		delete(overrides, p.text)
+		if measured[i] != predicted {
+			mismatch++
+			overrides[p.text] = measured[i]
+		}
+		if verbose || measured[i] != predicted {
+			p.line["measured"] = strconv.Itoa(measured[i])
+			f.ListLine(p.line, p.lists)
+		}
+	}
+
+	if mismatch > 0 || verbose {
+		f.Print(zli.Stdout)
+	}
+	if as == printAsList {
+		if mismatch > 0 || verbose {
+			fmt.Fprintln(zli.Stdout)
+		}
+		fmt.Fprintf(zli.Stdout, "%d of %d have a different width than predicted\n", mismatch, len(probes))
+	}
+	if save {
+		path, err := saveWidths(overrides)
+		if err != nil {
+			return fmt.Errorf("termwidth: %w", err)
+		}
+		if as == printAsList {
+			fmt.Fprintf(zli.Stdout, "Wrote %d overrides to %s\n", len(overrides), path)
+		}
+	}
 	return nil
 }
 
@@ -2,18 +2,29 @@ package main
 
 import (
 	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/andybalholm/brotli"
+	"zgo.at/uni/v2/unidata"
 	"zgo.at/zli"
 	"zgo.at/zstd/ztest"
 )
 
 func init() {
 	isTerm = false
+	os.Setenv("UNI_CONFIG", "") // Don't load the user's config file.
 }
 
 func TestCLI(t *testing.T) {
@@ -30,6 +41,8 @@ func TestCLI(t *testing.T) {
 		{[]string{"e", "-tone", "xx"}, "invalid skin"},
 		{[]string{"e", "-gender", "xx"}, "invalid gender"},
 		{[]string{"e", "-g", "xxsxxxx"}, `invalid gender: "xxsxxxx"`},
+		{[]string{"co", "x"}, `ambigious command: "co"`},
+		{[]string{"t", "x"}, `ambigious command: "t"`},
 	}
 
 	for _, tt := range tests {
@@ -51,6 +64,31 @@ func TestCLI(t *testing.T) {
 		})
 	}
 
+	t.Run("aliases", func(t *testing.T) {
+		run := func(args ...string) string {
+			_, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, args...)
+			main()
+			return out.String()
+		}
+		for _, a := range [][]string{
+			{"l", "list", "planes"},
+			{"ls", "list", "planes"},
+			{"i", "identify", "a"},
+			{"id", "identify", "a"},
+			{"s", "search", "euro"},
+			{"se", "search", "euro"},
+			{"e", "emoji", "grinning"},
+			{"em", "emoji", "grinning"},
+			{"emo", "emoji", "grinning"},
+			{"emoj", "emoji", "grinning"},
+		} {
+			if have, want := run(a[0], a[2]), run(a[1], a[2]); have != want {
+				t.Errorf("%q not the same as %q\nhave: %q\nwant: %q", a[0], a[1], have, want)
+			}
+		}
+	})
+
 	t.Run("usage", func(t *testing.T) {
 		if strings.Contains(usage, "\t") {
 			t.Errorf("usage text contains tabs")
@@ -133,6 +171,12 @@ func TestSearch(t *testing.T) {
 
 		// factorial from aliases
 		{[]string{"-q", "s", "factorial"}, "EXCLAMATION MARK", 1, -1},
+
+		{[]string{"-q", "-f", "%name", "s", "-sort", "name", "euro", "sign"},
+			"BANKNOTE WITH EURO SIGN\nEURO SIGN\nEURO-CURRENCY SIGN\n", 3, -1},
+		{[]string{"-q", "-f", "%name", "s", "-sort", "name-collated", "-locale", "und-u-ka-shifted", "euro", "sign"},
+			"BANKNOTE WITH EURO SIGN\nEURO-CURRENCY SIGN\nEURO SIGN\n", 3, -1},
+		{[]string{"-q", "s", "-sort", "name-collated", "-f", "%cpoint", "euro"}, `can't sort on "name"`, 1, 1},
 	}
 
 	for _, tt := range tests {
@@ -378,6 +422,100 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+func TestList(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"emoji-groups"}, `
+			Name               Subgroups  Emojis
+			Smileys & Emotion         16     169
+			People & Body             16     191
+			Component                  2       0
+			Animals & Nature           8     159
+			Food & Drink               7     131
+			Travel & Places           11     218
+			Activities                 5      85
+			Objects                   18     264
+			Symbols                   14     222
+			Flags                      3     270
+		`},
+		{[]string{"-order", "assigned", "-c", "emoji-g"}, `
+			Component           2    0
+			Activities          5   85
+			Food & Drink        7  131
+			Animals & Nature    8  159
+			Smileys & Emotion  16  169
+			People & Body      16  191
+			Travel & Places    11  218
+			Symbols            14  222
+			Objects            18  264
+			Flags               3  270
+		`},
+		{[]string{"-as", "csv", "-f", "all", "-order", "name", "emoji-sub"}, `
+			group,name,emojis
+			Symbols,alphanum,39
+			Animals & Nature,animal-amphibian,1
+		`},
+		{[]string{"-f", "%(code) %(name) %(age)", "-order", "age", "-c", "scripts"}, `
+			Arab Arabic 1.1
+			Armn Armenian 1.1
+		`},
+		{[]string{"-as", "csv", "-f", "all", "planes"}, `
+			from,to,range,size,assigned,age,name
+			U+0000,U+FFFF,U+0000..U+FFFF,65536,64104,1.1,Basic Multilingual Plane
+		`},
+		{[]string{"-as", "csv", "-f", "+%(code)", "scripts"}, `
+			name,assigned,code
+			Adlam,88,Adlm
+		`},
+		{[]string{"-c", "-f", "%(range) %(assigned) %(age)", "-order", "start", "blocks"}, `
+			U+0000..U+007F 128 1.1
+			U+0080..U+00FF 128 1.1
+		`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "list"}, tt.in...)
+			main()
+
+			want := strings.TrimSpace(ztest.NormalizeIndent(tt.want))
+			got := strings.ReplaceAll(outbuf.String(), "\r\n", "\n")
+			if !strings.HasPrefix(got, want) {
+				t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			in      []string
+			wantErr string
+		}{
+			{[]string{"-order", "x", "b"}, `-order flag: unknown or ambiguous value "x"; must be name, start, assigned, or age`},
+			{[]string{"-order", "a", "b"}, `-order flag: unknown or ambiguous value "a"; must be name, start, assigned, or age`},
+			{[]string{"-f", "%(cpoint)", "b"}, ""},
+			{[]string{"xxx"}, ""},
+		}
+		for _, tt := range tests {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni", "list"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+			if *exit != 1 {
+				t.Errorf("%v: exit %d: %s", tt.in, *exit, out)
+			}
+			if tt.wantErr != "" && !strings.Contains(out.String(), tt.wantErr) {
+				t.Errorf("%v: wrong error\nhave: %s\nwant: %s", tt.in, out, tt.wantErr)
+			}
+		}
+	})
+}
+
 func TestJSON(t *testing.T) {
 	_, _, outbuf := zli.Test(t)
 
@@ -385,31 +523,40 @@ func TestJSON(t *testing.T) {
 	main()
 
 	want := ` [{
-	"aliases": "",
-	"bin":     "10000010101100",
-	"block":   "Currency Symbols",
-	"cat":     "Currency_Symbol",
-	"cells":   "1",
-	"char":    "€",
-	"cpoint":  "U+20AC",
-	"dec":     "8364",
-	"digraph": "=e",
-	"hex":     "20ac",
-	"html":    "&euro;",
-	"json":    "\\u20ac",
-	"keysym":  "EuroSign",
-	"name":    "EURO SIGN",
-	"oct":     "20254",
-	"plane":   "Basic Multilingual Plane",
-	"props":   "",
-	"refs":    "U+20A0",
-	"script":  "Common",
-	"unicode": "2.1",
-	"utf16be": "20 ac",
-	"utf16le": "ac 20",
-	"utf8":    "e2 82 ac",
-	"width":   "ambiguous",
-	"xml":     "&#x20ac;"
+	"aliases":      "",
+	"ascii":        "EUR",
+	"bin":          "10000010101100",
+	"block":        "Currency Symbols",
+	"cat":          "Currency_Symbol",
+	"cells":        "1",
+	"char":         "€",
+	"compose":      "<Multi_key> <C> <equal>, <Multi_key> <equal> <C>, <Multi_key> <c> <equal>, <Multi_key> <equal> <c>, <Multi_key> <E> <equal>, <Multi_key> <equal> <E>, <Multi_key> <e> <equal>, <Multi_key> <equal> <e>, <Multi_key> <Cyrillic_ES> <equal>, <Multi_key> <equal> <Cyrillic_ES>, <Multi_key> <Cyrillic_IE> <equal>, <Multi_key> <equal> <Cyrillic_IE>",
+	"cpoint":       "U+20AC",
+	"dec":          "8364",
+	"digraph":      "=e",
+	"hex":          "20ac",
+	"html":         "&euro;",
+	"id_status":    "Restricted",
+	"id_type":      "Not_XID",
+	"idna":         "valid",
+	"idna_mapping": "",
+	"in_font":      "",
+	"json":         "\\u20ac",
+	"keysym":       "EuroSign",
+	"latex":        "",
+	"name":         "EURO SIGN",
+	"oct":          "20254",
+	"plane":        "Basic Multilingual Plane",
+	"props":        "",
+	"refs":         "U+20A0",
+	"script":       "Common",
+	"sortkey":      "1f 83 00 00 00 20 00 00 00 02",
+	"unicode":      "2.1",
+	"utf16be":      "20 ac",
+	"utf16le":      "ac 20",
+	"utf8":         "e2 82 ac",
+	"width":        "ambiguous",
+	"xml":          "&#x20ac;"
 }]
 `
 	got := outbuf.String()
@@ -419,6 +566,1651 @@ func TestJSON(t *testing.T) {
 	}
 }
 
+func TestJSONTyped(t *testing.T) {
+	// Compare against the json.Marshaler from unidata, which should have the
+	// same shape.
+	cmp := func(t *testing.T, got, want map[string]any) {
+		t.Helper()
+		for k, w := range want {
+			g, ok := got[k]
+			if !ok {
+				t.Errorf("missing key %q", k)
+				continue
+			}
+			if !reflect.DeepEqual(g, w) {
+				t.Errorf("key %q:\ngot:  %#v\nwant: %#v", k, g, w)
+			}
+		}
+	}
+	run := func(t *testing.T, args ...string) []map[string]any {
+		t.Helper()
+		_, _, outbuf := zli.Test(t)
+		os.Args = append([]string{"testuni"}, args...)
+		main()
+
+		var got []map[string]any
+		err := json.Unmarshal(outbuf.Bytes(), &got)
+		if err != nil {
+			t.Fatalf("%s\n%s", err, outbuf.String())
+		}
+		if len(got) == 0 {
+			t.Fatalf("no output")
+		}
+		return got
+	}
+	marshal := func(t *testing.T, v any) map[string]any {
+		t.Helper()
+		j, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]any
+		err = json.Unmarshal(j, &m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	for _, c := range []rune{'€', '·', 'a', 0x1f600, 0x0301} {
+		t.Run(string(c), func(t *testing.T) {
+			got := run(t, "p", "-raw", "-f", "all", "-json-typed", fmt.Sprintf("U+%04X", c))[0]
+			info, _ := unidata.Find(c)
+			cmp(t, got, marshal(t, info))
+
+			if _, ok := got["dec"].(float64); !ok {
+				t.Errorf("dec not a number: %#v", got["dec"])
+			}
+			if _, ok := got["props"].([]any); !ok {
+				t.Errorf("props not a list: %#v", got["props"])
+			}
+		})
+	}
+
+	t.Run("emoji", func(t *testing.T) {
+		got := run(t, "e", "-f", "all", "-as", "json-typed", "kissing cat")[0]
+		var want unidata.Emoji
+		for _, e := range unidata.Emojis {
+			if e.Name == "kissing cat" {
+				want = e
+			}
+		}
+		m := marshal(t, want)
+		if _, ok := m["shortcode"]; !ok {
+			t.Error("no shortcode in json.Marshal() output")
+		}
+		cmp(t, got, m)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		tests := []struct {
+			args []string
+			col  string
+			want []any
+		}{
+			{[]string{"i", "-f", "%(compose)", "¸"}, "compose",
+				[]any{"<Multi_key> <comma> <space>", "<Multi_key> <space> <comma>", "<Multi_key> <comma> <comma>"}},
+			{[]string{"i", "-f", "%(aliases)", "a"}, "aliases", []any{}},
+			{[]string{"restriction", "aα"}, "scripts", []any{"Latin", "Greek"}},
+		}
+		for _, tt := range tests {
+			got := run(t, append([]string{tt.args[0], "-as", "json-typed"}, tt.args[1:]...)...)[0]
+			if !reflect.DeepEqual(got[tt.col], tt.want) {
+				t.Errorf("%s:\nhave: %#v\nwant: %#v", tt.args, got[tt.col], tt.want)
+			}
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		for _, l := range run(t, "list", "-json-typed", "-compact", "categories") {
+			if _, ok := l["assigned"].(float64); !ok {
+				t.Errorf("assigned not a number: %#v", l["assigned"])
+			}
+		}
+	})
+}
+
+// Make sure all columns are in the schema.
+func TestJSONSchema(t *testing.T) {
+	b, err := os.ReadFile("uni.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema struct {
+		Items struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"items"`
+	}
+	err = json.Unmarshal(b, &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cols := append(knownColumns, "emoji", "group", "subgroup", "cldr", "cldr_full", "shortcode", "assigned", "measured")
+	for _, l := range listTypes {
+		cols = append(cols, l.cols...)
+	}
+	for _, c := range cols {
+		if c == "wide_padding" {
+			continue
+		}
+		if _, ok := schema.Items.Properties[c]; !ok {
+			t.Errorf("column %q not in uni.schema.json", c)
+		}
+	}
+}
+
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"i", "-as", "csv", "-f", "%(char) %(cpoint) %(name)", `",a`},
+			"char,cpoint,name\r\n\"\"\"\",U+0022,QUOTATION MARK\r\n\",\",U+002C,COMMA\r\na,U+0061,LATIN SMALL LETTER A\r\n"},
+		{[]string{"i", "-as", "csv", "-c", "-f", "%(char q l:3)%(wide_padding) %(cpoint l:auto)", "a"},
+			"a,U+0061\r\n"},
+		{[]string{"p", "-as", "tsv", "-f", "%(cpoint) %(name) %(aliases)", "U+21"},
+			"cpoint\tname\taliases\nU+0021\tEXCLAMATION MARK\tfactorial, bang\n"},
+		{[]string{"e", "-as", "tsv", "-c", "-f", "%(emoji)%(tab)%(name)", "grinning face"},
+			"😀\tgrinning face\n"},
+		{[]string{"ls", "-as", "csv", "planes"},
+			"from,to,name\r\nU+0000,U+FFFF,Basic Multilingual Plane\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.args...)
+			main()
+
+			out := outbuf.String()
+			if !strings.HasPrefix(out, tt.want) {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownHTML(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"i", "-as", "markdown", "-f", "%(char q h l:3) %(dec r:auto) %(name)", `a|`},
+			"| Char | Dec | Name                 |\n" +
+				"| ---- | --: | -------------------- |\n" +
+				"| a    |  97 | LATIN SMALL LETTER A |\n" +
+				"| \\|   | 124 | VERTICAL LINE        |\n"},
+		{[]string{"i", "-as", "md", "-c", "-f", "%(char) %(html)", "*&"},
+			"| Char | HTML |\n| --- | --- |\n| \\* | \\&ast; |\n| \\& | \\&amp; |\n"},
+		{[]string{"i", "-as", "html", "-c", "-f", "%(char) %(cpoint r:auto)", "<"},
+			"<table>\n<thead><tr><th>Char</th><th class=\"r\">CPoint</th></tr></thead>\n<tbody>\n" +
+				"<tr><td>&lt;</td><td class=\"r\">U+003C</td></tr>\n</tbody>\n</table>\n"},
+		{[]string{"p", "-as", "html-table", "-c", "U+41", "U+43"},
+			"<tr><th>U+004x</th><td class=\"unselected\"></td><td title=\"U+0041 LATIN CAPITAL LETTER A\">A</td>" +
+				"<td class=\"unselected\"></td><td title=\"U+0043 LATIN CAPITAL LETTER C\">C</td><td></td>"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.args...)
+			main()
+
+			out := outbuf.String()
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("page", func(t *testing.T) {
+		_, _, outbuf := zli.Test(t)
+		os.Args = []string{"uni", "i", "-as", "html", "a"}
+		main()
+
+		out := outbuf.String()
+		if !strings.HasPrefix(out, "<!DOCTYPE html>") || !strings.HasSuffix(out, "</html>\n") {
+			t.Errorf("not a full page:\n%s", out)
+		}
+	})
+}
+
+func TestTemplate(t *testing.T) {
+	t.Cleanup(func() { outputTemplate = nil })
+
+	tmp := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmp, "tpl"), []byte("{{.Emoji}} {{join \"/\" .CPoint}}\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"i", "-template", "{{.Char}} {{.Dec}} {{lower .Name}}{{range .Aliases}} [{{.}}]{{end}}", "a!"},
+			"a 97 latin small letter a\n! 33 exclamation mark [factorial] [bang]\n"},
+		{[]string{"i", "-as", "template", "-template", "{{pad 8 .CPoint}}|{{padLeft 4 (upper .Hex)}}|", "€"},
+			"U+20AC  |20AC|\n"},
+		{[]string{"i", "-template", "{{padLeft 5 .Dec}}|{{pad 3 .Cells}}|", "€"},
+			" 8364|1  |\n"},
+		{[]string{"p", "-template", "{{if eq .Hex \"43\"}}{{.Name}}{{end}}", "U+41..U+46"},
+			"LATIN CAPITAL LETTER C\n"},
+		{[]string{"s", "-sort", "name", "-template", "{{.Name}}", "euro"},
+			"BANKNOTE WITH EURO SIGN\nEARTH GLOBE EUROPE-AFRICA\n"},
+		{[]string{"e", "-template-file", filepath.Join(tmp, "tpl"), "grinning face"},
+			"😀 U+1F600\n"},
+		{[]string{"ls", "scripts", "-template", "{{.name}}={{.assigned}}"},
+			"Adlam=88\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.args...)
+			main()
+
+			out := outbuf.String()
+			if !strings.HasPrefix(out, tt.want) {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"i", "-as", "template", "x"},
+			{"i", "-as", "json", "-template", "{{.Name}}", "x"},
+			{"i", "-template", "{{", "x"},
+			{"i", "-template", "{{.Foo}}", "x"},
+		} {
+			exit, _, _ := zli.Test(t)
+			os.Args = append([]string{"uni"}, args...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+			if *exit != 1 {
+				t.Errorf("%v: exit %d", args, *exit)
+			}
+		}
+	})
+}
+
+func TestRegex(t *testing.T) {
+	t.Cleanup(func() { regexFlavor = "go" })
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"i", "-as", "regex", "hello-"}, `[\x{002D}ehlo]`},
+		{[]string{"p", "-as", "regex", "U+41..U+43", "U+45", "U+46"}, `[A-CEF]`},
+		{[]string{"p", "-as", "regex", "-flavor", "js", "U+E9", "U+1F600"}, `/[\u00E9\u{1F600}]/u`},
+		{[]string{"e", "-as", "regex", "-flavor", "js", "keycap: 10"}, `/(?:\u{1F51F})/u`},
+		{[]string{"p", "-as", "regex", "-flavor", "python", "U+E9", "U+1F600"}, `[\u00E9\U0001F600]`},
+		{[]string{"p", "-as", "regex", "-flavor", "posix", "U+2D", "U+5B..U+5E", "U+E9"}, `[]\é[^-]`},
+		{[]string{"p", "-as", "regex", "-flavor", "posix", "U+5E"}, `\^`},
+		{[]string{"e", "-as", "regex", "keycap: 1", "-or", "keycap: 10"},
+			`(?:1\x{FE0F}\x{20E3}|\x{1F51F})`},
+		{[]string{"e", "-as", "regex", "-flavor", "posix", "keycap: *"}, `(\*️⃣)`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.args...)
+			main()
+
+			out := strings.TrimSpace(outbuf.String())
+			if out != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("compile", func(t *testing.T) {
+		_, _, outbuf := zli.Test(t)
+		os.Args = []string{"uni", "p", "-as", "regex", "script:greek"}
+		main()
+		re := regexp.MustCompile(`^` + strings.TrimSpace(outbuf.String()) + `+$`)
+		if !re.MatchString("αβγΩ") || re.MatchString("abc") {
+			t.Error(re)
+		}
+
+		_, _, outbuf = zli.Test(t)
+		os.Args = []string{"uni", "e", "-as", "regex", "-tone", "all", "-gender", "all", "all"}
+		main()
+		re = regexp.MustCompile(strings.TrimSpace(outbuf.String()))
+		for _, e := range []string{"👍🏽", "🧑‍🚒", "🏳️‍🌈", "🇳🇱"} {
+			if m := re.FindString("x" + e + "x"); m != e {
+				t.Errorf("%q: %q", e, m)
+			}
+		}
+	})
+}
+
+func TestCodegen(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"-lang", "go", "-name", "isUpper", "U+41..U+43", "U+100", "U+102", "U+104", "U+1F600"}, []string{
+			`// Code generated by "uni codegen -lang go -name isUpper U+41..U+43 U+100 U+102 U+104 U+1F600"; DO NOT EDIT.`,
+			"// Unicode 16.0; 7 codepoints in 5 ranges.",
+			"var isUpper = &unicode.RangeTable{\n" +
+				"\tR16: []unicode.Range16{\n" +
+				"\t\t{0x0041, 0x0043, 1},\n" +
+				"\t\t{0x0100, 0x0104, 2},\n" +
+				"\t},\n" +
+				"\tR32: []unicode.Range32{\n" +
+				"\t\t{0x1f600, 0x1f600, 1},\n" +
+				"\t},\n" +
+				"\tLatinOffset: 1,\n}\n",
+		}},
+		{[]string{"-lang", "c", "-name", "isUpper", "U+41..U+43", "U+1F600"}, []string{
+			"static const uint32_t isUpper_ranges[][2] = {\n\t{0x0041, 0x0043},\n\t{0x1F600, 0x1F600},\n};",
+			"bool isUpper(uint32_t cp) {",
+		}},
+		{[]string{"-lang", "rust", "-name", "isUpper", "U+41..U+43"}, []string{
+			"const IS_UPPER_RANGES: &[(u32, u32)] = &[\n    (0x0041, 0x0043),\n];",
+			"pub fn is_upper(c: char) -> bool {",
+		}},
+		{[]string{"-lang", "python", "-name", "isUpper", "U+41..U+42"}, []string{
+			"_IS_UPPER = frozenset((\n    0x0041,\n    0x0042,\n))",
+			"def is_upper(c):\n    return ord(c) in _IS_UPPER\n",
+		}},
+		{[]string{"-lang", "python", "-name", "is_greek", "script:greek"}, []string{
+			"import bisect",
+			"_IS_GREEK_STARTS = (\n    0x0370,\n",
+			"def is_greek(c):",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "codegen"}, tt.args...)
+			main()
+
+			out := outbuf.String()
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("output doesn't contain %q:\n%s", w, out)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	write := func(t *testing.T, conf string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "config")
+		err := os.WriteFile(path, []byte(ztest.NormalizeIndent(conf)), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	conf := write(t, `
+		# Comment
+		as = json
+
+		[identify]
+		format = @short
+
+		[emoji]
+		as     = list
+		format = "%(emoji h) %(name) "
+		tone   = dark
+
+		[preset]
+		short = %(cpoint) %(name)
+	`)
+	other := write(t, `
+		[preset]
+		short = %(char) %(cpoint)
+	`)
+
+	tests := []struct {
+		env  string
+		in   []string
+		want string
+	}{
+		{conf, []string{"i", "-c", "€"}, `[{"cpoint":"U+20AC","name":"EURO SIGN"}]`},
+		{conf, []string{"i", "-as", "list", "€"}, "CPoint Name\nU+20AC EURO SIGN"},
+		{conf, []string{"i", "-as", "list", "-f", "%(dec)", "€"}, "Dec\n8364"},
+		{conf, []string{"p", "-c", "-f", "@short", "U+41"}, `[{"cpoint":"U+0041","name":"LATIN CAPITAL LETTER A"}]`},
+		{conf, []string{"e", "-c", "thumbs up"}, "👍🏿 thumbs up: dark skin tone\n"},
+		{conf, []string{"e", "-c", "-tone", "none", "thumbs up"}, "👍 thumbs up\n"},
+		{conf + string(os.PathListSeparator) + other, []string{"p", "-c", "-as", "list", "-f", "@short", "U+41"}, "A U+0041"},
+
+		{conf, []string{"config", "show", "e"}, "[emoji]\nformat = \"%(emoji h) %(name) \"\nas     = list\ntone   = dark\n"},
+		{conf, []string{"config", "show"}, "as = json\n\n[identify]\nformat = @short\n\n[emoji]\nformat"},
+		{"", []string{"config", "show"}, "# No config file loaded; $UNI_CONFIG is empty.\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			t.Setenv("UNI_CONFIG", tt.env)
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			main()
+
+			if !strings.Contains(outbuf.String(), tt.want) {
+				t.Errorf("\ngot:\n%s\nwant:\n%s", outbuf.String(), tt.want)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			conf, want string
+		}{
+			{"[xxx]", `unknown section "xxx"`},
+			{"[emoji", `no closing ]`},
+			{"width = 2", `unknown key "width"`},
+			{"pager = maybe", `pager: not a boolean`},
+			{"xxx", `need key = value`},
+			{"[preset]\nx = %(name)", `no preset "nope"; defined presets are: x`},
+		}
+		for _, tt := range tests {
+			t.Setenv("UNI_CONFIG", write(t, tt.conf))
+			exit, _, out := zli.Test(t)
+			os.Args = []string{"uni", "i", "-f", "@nope", "x"}
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+			if *exit != 1 || !strings.Contains(out.String(), tt.want) {
+				t.Errorf("%q: exit %d\ngot:  %s\nwant: %s", tt.conf, *exit, out, tt.want)
+			}
+		}
+	})
+}
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    string
+		wantErr string
+	}{
+		{[]string{"i", "-f", "%(char) %(compose)", "ŋ✓"},
+			"Char Compose\nŋ <Multi_key> <n> <g>\n✓\n", ""},
+		{[]string{"i", "-template", "{{.Char}}{{range .Compose}} [{{.}}]{{end}}", "ŋ✓"},
+			"ŋ [<Multi_key> <n> <g>]\n✓\n", ""},
+		{[]string{"p", "-f", "%(char) %(name)", "compose:oo"},
+			"Char Name\n° DEGREE SIGN\n", ""},
+		{[]string{"p", "-f", "%(char) %(name)", "compose:<Multi_key> <C> <equal>"},
+			"Char Name\n€ EURO SIGN\n", ""},
+		{[]string{"p", "-f", "%(char)", "compose:C="},
+			"Char\n€\n", ""},
+		{[]string{"p", "-f", "%(char)", "compose:c="},
+			"Char\n€\n", ""},
+		{[]string{"p", "compose:zzz"}, "", `no Compose sequence starts with "zzz"`},
+		{[]string{"p", "compose:<nope>"}, "", `unknown key in Compose sequence: "<nope>"`},
+
+		{[]string{"xcompose", "U+2200..U+2203", "U+20AC", "U+41"},
+			"# Generated by \"uni xcompose\"; the sequences are the RFC 1345 digraphs.\n" +
+				"# Save as ~/.XCompose to use it; the include keeps the default sequences.\n" +
+				"include \"%L\"\n\n" +
+				"<Multi_key> <F> <A>\t: \"∀\"\tU2200 # FOR ALL\n" +
+				"# No free sequence\t: \"∁\"\tU2201 # COMPLEMENT\n" +
+				"<Multi_key> <d> <P>\t: \"∂\"\tpartialderivative # PARTIAL DIFFERENTIAL\n" +
+				"<Multi_key> <T> <E>\t: \"∃\"\tU2203 # THERE EXISTS\n", ""},
+		{[]string{"xcompose", "U+20AC"}, "", "no matches"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			have := out.String()
+			if tt.wantErr != "" {
+				if *exit != 1 || !strings.Contains(have, tt.wantErr) {
+					t.Errorf("exit %d\nhave: %q\nwant: %q", *exit, have, tt.wantErr)
+				}
+				return
+			}
+			if have != tt.want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, tt.want)
+			}
+		})
+	}
+
+	// Generated sequences must never conflict with the default ones, or with
+	// each other.
+	t.Run("conflicts", func(t *testing.T) {
+		_, _, out := zli.Test(t)
+		os.Args = []string{"uni", "xcompose"}
+		main()
+
+		var seqs []string
+		for _, line := range strings.Split(out.String(), "\n") {
+			if !strings.HasPrefix(line, "<Multi_key>") {
+				continue
+			}
+			var keys strings.Builder
+			for _, m := range reComposeKey.FindAllStringSubmatch(line[:strings.Index(line, ":")], -1)[1:] {
+				r, ok := unidata.FindComposeKey(m[1])
+				if !ok {
+					t.Fatalf("unknown key %q in %q", m[1], line)
+				}
+				keys.WriteRune(r)
+			}
+			if unidata.ComposeConflicts(keys.String()) {
+				t.Errorf("conflicts with default: %q", line)
+			}
+			seqs = append(seqs, keys.String())
+		}
+		if len(seqs) < 500 {
+			t.Fatalf("only %d sequences", len(seqs))
+		}
+		for i, a := range seqs {
+			for _, b := range seqs[i+1:] {
+				if strings.HasPrefix(a, b) || strings.HasPrefix(b, a) {
+					t.Errorf("conflict: %q and %q", a, b)
+				}
+			}
+		}
+	})
+}
+
+func TestLaTeX(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    string
+		wantErr string
+	}{
+		{[]string{"i", "-f", "%(char) %(latex)", "→ℝ€"},
+			"Char LaTeX\n→ \\rightarrow, \\to\nℝ \\mathbb{R}, \\BbbR\n€\n", ""},
+		{[]string{"i", "-template", "{{.Char}}{{range .LaTeX}} {{.}}{{end}}", "→€"},
+			"→ \\rightarrow \\to\n€\n", ""},
+		{[]string{"p", "-f", "%(char) %(name)", `latex:\alpha`, `latex:\mathbb{R}`, "latex:Delta"},
+			"Char Name\nα GREEK SMALL LETTER ALPHA\nℝ DOUBLE-STRUCK CAPITAL R\nΔ GREEK CAPITAL LETTER DELTA\n", ""},
+		{[]string{"p", `latex:\nope`}, "", `unknown LaTeX name: "\\nope"`},
+
+		{[]string{"latex", `\alpha^2 + \beta_1 \leq \infty`}, "α² + β₁ ≤ ∞\n", ""},
+		{[]string{"latex", `x^{-1}`, `\mathbb{R}^n \to \mathcal F`}, "x⁻¹ ℝⁿ → ℱ\n", ""},
+		{[]string{"latex", `\sum_{i=1}^n a_{ij}`}, "∑ᵢ₌₁ⁿ aᵢⱼ\n", ""},
+		{[]string{"latex", `\forall x \in \mathbb{R}: e^{-x^2} > 0`}, "∀ x ∈ ℝ: e⁻ˣ² > 0\n", ""},
+		{[]string{"latex", `\hat{x} \vec v \not\in \{1, 2\}`}, "x̂ v⃗ ∉ {1, 2}\n", ""},
+		{[]string{"latex", `\mathbf{\alpha} \mathrm{d}x \left(a\,b\right)`}, "𝛂 dx (a\u2009b)\n", ""},
+		{[]string{"latex", `x_q`}, "uni: latex: no subscript variant for 'q'; writing _q\nx_q\n", ""},
+		{[]string{"latex", `x^{-1} e^{i\pi}`}, "uni: latex: no superscript variant for 'π'; writing ^(iπ)\nx⁻¹ e^(iπ)\n", ""},
+		{[]string{"latex", `\mathbb{1!}`}, "uni: latex: \\mathbb: no double-struck variant for '!'; writing \"1!\" unstyled\n1!\n", ""},
+		{[]string{"latex", `\foo`}, "", `latex: unknown command: \foo`},
+		{[]string{"latex", `x^`}, "", `latex: missing argument`},
+		{[]string{"latex", `{x`}, "", `latex: unbalanced "{"`},
+		{[]string{"latex", `x}`}, "", `latex: unbalanced "}"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			have := out.String()
+			if tt.wantErr != "" {
+				if *exit != 1 || !strings.Contains(have, tt.wantErr) {
+					t.Errorf("exit %d\nhave: %q\nwant: %q", *exit, have, tt.wantErr)
+				}
+				return
+			}
+			if have != tt.want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintLookup(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    string
+		wantErr string
+	}{
+		{[]string{"p", "-f", "%(cpoint) %(html)", "&rarr;", "html:RightArrow", "html:amp", "&NotEqualTilde;"},
+			"CPoint HTML\nU+2192 &rarr;\nU+2192 &rarr;\nU+0026 &amp;\nU+2242 &esim;\nU+0338 &#x338;\n", ""},
+		{[]string{"p", "-f", "%(cpoint) %(keysym)", "keysym:EuroSign", "keysym:Greek_alpha"},
+			"CPoint Keysym\nU+20AC EuroSign\nU+03B1 Greek_alpha\n", ""},
+		{[]string{"p", "-f", "%(cpoint) %(digraph)", "digraph:Eu", "digraph:=e", "digraph:a:", "digraph:A:"},
+			"CPoint Digraph\nU+20AC =e\nU+20AC =e\nU+00E4 a:\nU+00C4 A:\n", ""},
+		{[]string{"p", "&nope;"}, "", `unknown HTML entity: "&nope;"`},
+		{[]string{"p", "html:RARR"}, "", `unknown HTML entity: "RARR"`},
+		{[]string{"p", "keysym:eurosign"}, "", `unknown keysym: "eurosign"`},
+		{[]string{"p", "digraph:QQ"}, "", `unknown digraph: "QQ"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			have := out.String()
+			if tt.wantErr != "" {
+				if *exit != 1 || !strings.Contains(have, tt.wantErr) {
+					t.Errorf("exit %d\nhave: %q\nwant: %q", *exit, have, tt.wantErr)
+				}
+				return
+			}
+			if have != tt.want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortcode(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"e", ":thumbsup:", "-c", "-f", "%(emoji) %(shortcode)"},
+			"👍 :+1:, :thumbsup:, :thumbs_up:\n"},
+		{[]string{"e", "shortcode:tada", "-c", "-f", "%(emoji) %(name)"},
+			"🎉 party popper\n"},
+		{[]string{"e", ":TADA:", "-c", "-t", "dark", "-f", "%(emoji) %(shortcode)"},
+			"🎉 :tada:, :party_popper:\n"},
+		{[]string{"e", ":+1:", "-c", "-t", "dark", "-f", "%(emoji) %(shortcode)"},
+			"👍🏿 :+1::skin-tone-6:, :thumbsup::skin-tone-6:, :thumbs_up::skin-tone-6:\n"},
+		{[]string{"e", "-as", "json-typed", "-f", "%(shortcode)", ":netherlands:"},
+			"[{\n\t\"shortcode\": [\":netherlands:\", \":flag_netherlands:\"]\n}]\n"},
+
+		{[]string{"emojify", ":tada: :thumbsup::skin-tone-3: :thumbsup_tone2: :party_popper: 10:30:00 :nope: :heart:"},
+			"🎉 👍🏼 👍🏼 🎉 10:30:00 :nope: ❤️\n"},
+		{[]string{"demojify", "🎉 👍🏼 🤦🏻‍♀️ ❤️ © #️⃣ 🇳🇱 x"},
+			":tada: :+1::skin-tone-3: :woman_facepalming::skin-tone-2: :heart: © :hash: :netherlands: x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if *exit != -1 {
+				t.Fatalf("exit %d: %s", *exit, out)
+			}
+			if have := out.String(); have != tt.want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"-c", "h"}, "help\n"},
+		{[]string{"co"}, "codegen\nconfig\ncompletion\n"},
+		{[]string{"p", "-c", "-wi"}, "-width-model\n"},
+		{[]string{"p", "-as", "json"}, "json\njson-typed\n"},
+		{[]string{"style", "-as", "bold-i"}, "bold-italic\n"},
+		{[]string{"e", "-tone", "mediumd"}, "mediumdark\n"},
+		{[]string{"i", "-f", ""}, "all\n"},
+		{[]string{"i", "-template", ""}, ""},
+		{[]string{"list", "emoji-"}, "emoji-groups\nemoji-subgroups\n"},
+		{[]string{"p", "b:box"}, "b:box-drawing\n"},
+		{[]string{"print", "Block:Box"}, "Block:box-drawing\n"},
+		{[]string{"p", "script:latin"}, "script:latin\n"},
+		{[]string{"p", "cat:dash"}, "cat:dash-punctuation\n"},
+		{[]string{"p", "p:white"}, "p:white-space\n"},
+		{[]string{"e", "g:face-sm"}, "g:face-smiling\n"},
+		{[]string{"termwidth", "emoji:group:hand-f"}, "emoji:group:hand-fingers-closed\nemoji:group:hand-fingers-open\nemoji:group:hand-fingers-partial\n"},
+		{[]string{"idna", "to"}, "toascii\ntounicode\n"},
+		{[]string{"idna", "check", "to"}, ""},
+		{[]string{"config", "show", "em"}, "emoji\n"},
+		{[]string{"completion", ""}, "bash\nzsh\nfish\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "__complete"}, tt.in...)
+			main()
+
+			if have := outbuf.String(); have != tt.want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, tt.want)
+			}
+		})
+	}
+
+	t.Run("names", func(t *testing.T) {
+		// All completed names should find the same thing.
+		for k, b := range unidata.Blocks {
+			if f, ok := unidata.FindBlock(completeName(b.Name)); !ok || f != k {
+				t.Errorf("block %q: %q", b.Name, completeName(b.Name))
+			}
+		}
+		for k, s := range unidata.Scripts {
+			if f, ok := unidata.FindScript(completeName(s.Name)); !ok || f != k {
+				t.Errorf("script %q: %q", s.Name, completeName(s.Name))
+			}
+		}
+		for k, c := range unidata.Categories {
+			if f, ok := unidata.FindCategory(completeName(c.Name)); !ok || f != k {
+				t.Errorf("category %q: %q", c.Name, completeName(c.Name))
+			}
+		}
+		for k, p := range unidata.Properties {
+			if f, ok := unidata.FindProperty(completeName(p.Name)); !ok || f != k {
+				t.Errorf("property %q: %q", p.Name, completeName(p.Name))
+			}
+		}
+	})
+
+	t.Run("scripts", func(t *testing.T) {
+		for _, sh := range []string{"bash", "zsh", "fish"} {
+			_, _, outbuf := zli.Test(t)
+			os.Args = []string{"uni", "completion", sh}
+			main()
+			if !strings.Contains(outbuf.String(), "uni __complete") {
+				t.Errorf("%s:\n%s", sh, outbuf.String())
+			}
+		}
+	})
+}
+
+func TestCopy(t *testing.T) {
+	tests := []struct {
+		in   []string
+		tmux bool
+		want string
+	}{
+		{[]string{"i", "-copy", "€a"}, false, "€a"},
+		{[]string{"p", "-copy", "-sort", "name", "U+41", "U+20AC"}, false, "€A"},
+		{[]string{"p", "-copy", "-as", "json", "-sort", "name", "U+41", "U+20AC"}, false, "€A"},
+		{[]string{"p", "-copy", "-as", "table", "U+41..U+42"}, false, "AB"},
+		{[]string{"p", "-copy", "-as", "regex", "U+41..U+42"}, false, "AB"},
+		{[]string{"p", "-copy-col", "cpoint", "-f", "%(name)", "U+41..U+42"}, false, "U+0041\nU+0042"},
+		{[]string{"e", "-copy", "-tone", "dark", "thumbs up"}, false, "👍🏿"},
+		{[]string{"i", "-copy", "x"}, true, "x"},
+		{[]string{"i", "-copy", "a\u0301"}, false, "a\u0301"},
+		{[]string{"p", "-copy", "U+07"}, false, "\a"},
+		{[]string{"p", "-copy", "-as", "table", "U+300..U+301"}, false, "\u0300\u0301"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			tty := new(bytes.Buffer)
+			save := copyTTY
+			t.Cleanup(func() { copyTTY, copyCol = save, "" })
+			copyTTY = func() (io.WriteCloser, error) { return nopCloser{tty}, nil }
+			t.Setenv("DISPLAY", "")
+			t.Setenv("WAYLAND_DISPLAY", "")
+			if tt.tmux {
+				t.Setenv("TMUX", "/tmp/tmux-1000/default,1,0")
+			} else {
+				t.Setenv("TMUX", "")
+			}
+
+			zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			main()
+
+			want := osc52(tt.want, tt.tmux)
+			if have := tty.String(); have != want {
+				t.Errorf("\nhave: %q\nwant: %q", have, want)
+			}
+		})
+	}
+
+	t.Run("tool", func(t *testing.T) {
+		tmp := t.TempDir()
+		err := os.WriteFile(filepath.Join(tmp, "xclip"), []byte("#!/bin/sh\ncat >"+filepath.Join(tmp, "clip")+"\n"), 0o755)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			env      []string
+			wantTool bool
+		}{
+			{[]string{"DISPLAY", ":0"}, true},
+			{[]string{"DISPLAY", ":0", "SSH_CONNECTION", "10.0.0.1 22 10.0.0.2 22"}, false},
+			{[]string{"DISPLAY", ":0", "TMUX", "/tmp/tmux-1000/default,1,0"}, false},
+			{[]string{"DISPLAY", ""}, false},
+		}
+		for _, tt := range tests {
+			t.Run(strings.Join(tt.env, " "), func(t *testing.T) {
+				os.Remove(filepath.Join(tmp, "clip"))
+				tty := new(bytes.Buffer)
+				save := copyTTY
+				t.Cleanup(func() { copyTTY, copyCol = save, "" })
+				copyTTY = func() (io.WriteCloser, error) { return nopCloser{tty}, nil }
+				t.Setenv("PATH", tmp+string(os.PathListSeparator)+os.Getenv("PATH"))
+				for _, k := range []string{"DISPLAY", "WAYLAND_DISPLAY", "SSH_CONNECTION", "SSH_TTY", "TMUX"} {
+					t.Setenv(k, "")
+				}
+				for i := 0; i < len(tt.env); i += 2 {
+					t.Setenv(tt.env[i], tt.env[i+1])
+				}
+
+				zli.Test(t)
+				os.Args = []string{"uni", "i", "-copy", "€"}
+				main()
+
+				clip, _ := os.ReadFile(filepath.Join(tmp, "clip"))
+				if tt.wantTool {
+					if string(clip) != "€" || tty.Len() > 0 {
+						t.Errorf("clip: %q; tty: %q", clip, tty)
+					}
+				} else {
+					if len(clip) > 0 || !strings.Contains(tty.String(), "]52;c;") {
+						t.Errorf("clip: %q; tty: %q", clip, tty)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			in   []string
+			want string
+		}{
+			{[]string{"list", "-copy", "blocks"}, "-copy only works with"},
+			{[]string{"i", "-copy-col", "nope", "x"}, `unknown column: "nope"`},
+			{[]string{"e", "-copy-col", "char", "x"}, `unknown column: "char"`},
+		}
+		for _, tt := range tests {
+			t.Cleanup(func() { copyCol = "" })
+			exit, _, out := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+			if *exit != 1 || !strings.Contains(out.String(), tt.want) {
+				t.Errorf("%q: exit %d\ngot:  %s\nwant: %s", tt.in, *exit, out, tt.want)
+			}
+		}
+	})
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+func TestServe(t *testing.T) {
+	srv := httptest.NewServer(newServer(uniConfig{presets: map[string]string{"short": "%(cpoint) %(name)"}}))
+	defer srv.Close()
+
+	get := func(t *testing.T, path string) (int, string) {
+		t.Helper()
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(b)
+	}
+
+	t.Run("api", func(t *testing.T) {
+		// Should be identical to the commandline.
+		tests := []struct {
+			path string
+			cli  []string
+		}{
+			{"/api/identify?q=€a", []string{"i", "-as", "json", "€a"}},
+			{"/api/identify?q=€&q=a&compact", []string{"i", "-c", "-as", "json", "€a"}},
+			{"/api/search?q=euro+sign&format=all", []string{"s", "-as", "json", "-f", "all", "euro", "sign"}},
+			{"/api/print?q=U%2B41..U%2B43&sort=name&format=%2B%25unicode", []string{"p", "-as", "json", "-sort", "name", "-f", "+%unicode", "U+41..U+43"}},
+			{"/api/print?q=U%2B41&format=@short", []string{"p", "-as", "json", "-f", "%(cpoint) %(name)", "U+41"}},
+			{"/api/emoji?q=thumbs+up&tone=dark&as=json-typed", []string{"e", "-as", "json-typed", "-tone", "dark", "thumbs", "up"}},
+			{"/api/emoji?q=thumbs+up&gender=all", []string{"e", "-as", "json", "-gender", "all", "thumbs", "up"}},
+			{"/api/list?q=planes&order=name", []string{"list", "-as", "json", "-order", "name", "planes"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.path, func(t *testing.T) {
+				_, _, outbuf := zli.Test(t)
+				os.Args = append([]string{"uni"}, tt.cli...)
+				main()
+
+				code, have := get(t, tt.path)
+				if code != 200 {
+					t.Fatalf("status %d: %s", code, have)
+				}
+				if want := outbuf.String(); have != want {
+					t.Errorf("\nhave:\n%s\nwant:\n%s", have, want)
+				}
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			path     string
+			wantCode int
+			want     string
+		}{
+			{"/api/search?q=nomatchxx", 200, "[]\n"},
+			{"/api/identify", 400, `{"error":"need a query in the q parameter"}` + "\n"},
+			{"/api/print?q=block:nomatchxx", 400, `{"error":"unknown or ambiguous block: \"nomatchxx\""}` + "\n"},
+			{"/api/emoji?q=x&tone=blue", 400, `{"error":"invalid skin tone: \"blue\""}` + "\n"},
+			{"/api/print?q=x&as=table", 400, `{"error":"as: must be json or json-typed, not \"table\""}` + "\n"},
+			{"/api/print?q=x&format=@nope", 400, `{"error":"-format flag: no preset \"nope\"; defined presets are: short"}` + "\n"},
+			{"/nope", 404, "404 page not found\n"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.path, func(t *testing.T) {
+				code, have := get(t, tt.path)
+				if code != tt.wantCode || have != tt.want {
+					t.Errorf("\nhave: %d %q\nwant: %d %q", code, have, tt.wantCode, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("ui", func(t *testing.T) {
+		tests := []struct {
+			path string
+			want string
+		}{
+			{"/", `<input name="q" value="" autofocus>`},
+			{"/?cmd=print&q=U%2B41", `<td title="U+0041 LATIN CAPITAL LETTER A">A</td>`},
+			{"/?cmd=print&q=U%2B41&view=list", "<td>LATIN CAPITAL LETTER A</td>"},
+			{"/?cmd=emoji&q=thumbs+up", "<td>thumbs up</td>"},
+			{"/?cmd=search&q=nomatchxx", `<p class="error">No matches.</p>`},
+			{"/?cmd=print&q=%3Cb%3E", `<p class="error">invalid codepoint: not a number or codepoint: &#34;&lt;b&gt;&#34;</p>`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.path, func(t *testing.T) {
+				code, have := get(t, tt.path)
+				if code != 200 || !strings.Contains(have, tt.want) {
+					t.Errorf("status %d\nhave:\n%s\nwant:\n%s", code, have, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRestriction(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"paypal", "ASCII-Only  Latin"},
+		{"café", "Single Script  Latin"},
+		{"日本語ひらがな", "Single Script  Han, Hiragana, Katakana"},
+		{"abc日本", "Highly Restrictive  Latin, Han, Hiragana, Katakana"},
+		{"abcअभी", "Moderately Restrictive  Latin, Devanagari"},
+		{"pаypal", "Minimally Restrictive  Latin, Cyrillic"},
+		{"pαypal", "Minimally Restrictive  Latin, Greek"},
+		{"pay pal", "Unrestricted  Latin"},
+		{"\u01c3", "Unrestricted  Latin"}, // Technical
+		{"\u01bf", "Unrestricted  Latin"}, // Obsolete
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = []string{"uni", "-q", "restriction", tt.in}
+			main()
+
+			out := strings.Join(strings.Fields(strings.TrimSpace(outbuf.String())), " ")
+			want := strings.Join(strings.Fields(tt.want), " ")
+			if !strings.HasSuffix(out, want) {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", out, want)
+			}
+		})
+	}
+}
+
+func TestIDType(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"a", "Allowed Recommended"},
+		{"\u01c3", "Restricted Technical"},
+		{"\u01bf", "Restricted Obsolete"},
+		{"\u018d", "Restricted Obsolete Technical"},
+		{"\ua78f", "Restricted Uncommon_Use"},
+		{"\u0710", "Restricted Limited_Use"},
+		{"\U00013000", "Restricted Exclusion"},
+		{"\u2020", "Restricted Not_XID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = []string{"uni", "-q", "i", "-c", "-f", "%(id_status) %(id_type)", tt.in}
+			main()
+
+			if have := strings.TrimSpace(outbuf.String()); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestIDNA(t *testing.T) {
+	tests := []struct {
+		in      []string
+		want    string
+		wantErr bool
+	}{
+		{[]string{"toascii", "Bücher.example"}, "xn--bcher-kva.example", false},
+		{[]string{"toascii", "ＢÜcher。example"}, "xn--bcher-kva.example", false},
+		{[]string{"toascii", "faß.de"}, "xn--fa-hia.de", false},
+		{[]string{"tounicode", "xn--bcher-kva.example"}, "bücher.example", false},
+		{[]string{"check", "bücher.example"}, "bücher.example", false},
+		{[]string{"check", "Bücher.example"}, "Bücher.example: idna: disallowed rune U+0042", true},
+		{[]string{"toascii", "a\u200db.com"}, "a\u200db.com: idna: invalid label \"a\\u200db\"", true},
+		{[]string{"toascii", "\u05d0a.com"}, "\u05d0a.com: idna: invalid label \"\u05d0a.com\"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			exit, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "-q", "idna"}, tt.in...)
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if have := strings.TrimSpace(outbuf.String()); !strings.HasPrefix(have, tt.want) {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+			if tt.wantErr != (*exit == 1) {
+				t.Errorf("wrong exit: %d", *exit)
+			}
+		})
+	}
+}
+
+func TestIDNAStatus(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"a", "valid"},
+		{"A", "mapped 'a'"},
+		{"_", "disallowed"},
+		{"\u1e9e", "mapped 'ss'"},
+		{"\u200d", "deviation"},
+		{"\u2474", "disallowed"},
+		{"\u2460", "mapped '1'"},
+		{"\u00df", "deviation 'ss'"},
+		{"\u00ad", "ignored"},
+		{"\u2028", "disallowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			// "id" is identify, not idna.
+			os.Args = []string{"uni", "-q", "id", "-c", "-f", "%(idna) %(idna_mapping Q)", tt.in}
+			main()
+
+			if have := strings.TrimSpace(outbuf.String()); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"sort", "zebra", "Ångström", "angel", "Apple", "älg", "Zulu", "öl"},
+			"älg angel Ångström Apple öl zebra Zulu"},
+		{[]string{"sort", "-locale", "sv", "zebra", "Ångström", "angel", "Apple", "älg", "Zulu", "öl"},
+			"angel Apple zebra Zulu Ångström älg öl"},
+		{[]string{"sort", "-locale", "de-u-co-phonebk", "Müller", "Mueller", "Muffler"},
+			"Mueller Müller Muffler"},
+		{[]string{"sort", "-strength", "primary", "-f", "%(sortkey) %(string)", "-c", "a", "A"},
+			"1f a2 a 1f a2 A"},
+		{[]string{"sort", "-f", "%(string)", "-c", "b", "\U0001f951", "a"},
+			"\U0001f951 a b"},
+		{[]string{"sort", "-f", "%(string)", "-c", "deluge", "de-luge", "de luge"},
+			"de luge de-luge deluge"},
+		{[]string{"sort", "-f", "%(string)", "-c", "-locale", "und-u-ka-shifted", "-strength", "quaternary", "deluge", "de-luge", "de luge"},
+			"de luge de-luge deluge"},
+		{[]string{"sort", "-f", "%(string)", "-c", "-locale", "und-u-ka-shifted", "-strength", "tertiary", "deluge", "de-luge", "de luge", "delugé"},
+			"deluge de-luge de luge delugé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni"}, tt.in...)
+			main()
+
+			if have := strings.Join(strings.Fields(outbuf.String()), " "); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslit(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"Crème brûlée – “naïve” ﬁx Straße"}, `Creme brulee - "naive" fix Strasse`},
+		{[]string{"Привет, мир! ЖУК Жук"}, "Privet, mir! ZHUK Zhuk"},
+		{[]string{"Ελληνικά"}, "Ellinika"},
+		{[]string{"서울 한국어"}, "seoul hangukeo"},
+		{[]string{"きゃっとカーペット ファイル"}, "kyattokaapetto fairu"},
+		{[]string{"北京abc"}, "bei jing abc"},
+		{[]string{"½ ⅞ 3∕4"}, "1/2 7/8 3/4"},
+		{[]string{"مرحبا שלום ٣٤"}, "mrhba shlvm 34"},
+		{[]string{"-scheme", "slug", "Crème Brûlée: the “Best” Recipe!"}, "creme-brulee-the-best-recipe"},
+		{[]string{"-scheme", "iso9", "Щука, Ёж"}, "Ŝuka, Ëž"},
+		{[]string{"-scheme", "pinyin", "北京abc"}, "běi jīng abc"},
+		{[]string{"-j", "Жук"}, `[{ "result": "Zhuk", "string": "Жук" }]`},
+		{[]string{"-v", "-c", "きゃé"}, "'きゃ' U+304D U+3083 'kya' HIRAGANA LETTER KI, HIRAGANA LETTER SMALL YA 'é' U+00E9 'e' LATIN SMALL LETTER E WITH ACUTE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "translit"}, tt.in...)
+			main()
+
+			if have := strings.Join(strings.Fields(outbuf.String()), " "); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyle(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"-as", "bold", "Hello", "123"}, "𝐇𝐞𝐥𝐥𝐨 𝟏𝟐𝟑"},
+		{[]string{"-as", "italic", "hi"}, "ℎ𝑖"},
+		{[]string{"-as", "script", "Be"}, "ℬℯ"},
+		{[]string{"-as", "fraktur", "Cat"}, "ℭ𝔞𝔱"},
+		{[]string{"-as", "double-struck", "R2"}, "ℝ𝟚"},
+		{[]string{"-as", "monospace", "go"}, "𝚐𝚘"},
+		{[]string{"-as", "sans", "ab"}, "𝖺𝖻"},
+		{[]string{"-as", "circled", "A1"}, "Ⓐ①"},
+		{[]string{"-as", "fullwidth", "a b"}, "ａ\u3000ｂ"},
+		{[]string{"-as", "smallcaps", "Hello"}, "Hᴇʟʟᴏ"},
+		{[]string{"-as", "superscript", "x2"}, "ˣ²"},
+		{[]string{"-as", "upside-down", "Hello!"}, "¡ollǝH"},
+		{[]string{"-plain", "𝓯𝓪𝓷𝓬𝔂 ⓣⓔⓧⓣ Ｗｉｄｅ ᴛᴇsᴛ ⑩"}, "fancy text Wide test 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "style"}, tt.in...)
+			main()
+
+			if have := strings.TrimSpace(outbuf.String()); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestFont(t *testing.T) {
+	t.Cleanup(func() { fontCoverage = nil })
+	fonts := writeTestFonts(t, 'A', 'B', 'C', '\u2764', '\U0001F600')
+
+	tests := []struct {
+		in   []string
+		want string
+	}{
+		{[]string{"test-fmt4.ttf"}, "Block Covered Assigned Percent " +
+			"Basic Latin 3 128 2% Dingbats 1 192 0% " +
+			"test-fmt4.ttf covers 4 codepoints in 2 blocks"},
+		{[]string{"test.ttf", "-c", "-f", "%(cpoint)=%(in_font)", "U+40..U+43", "U+1F600"},
+			"U+0040=no U+0041=yes U+0042=yes U+0043=yes U+1F600=yes"},
+		{[]string{"test-fmt4.ttf", "-c", "-f", "%(cpoint)=%(in_font)", "U+43..U+44", "U+1F600"},
+			"U+0043=yes U+0044=no U+1F600=no"},
+		{[]string{"test.ttc", "-c", "-f", "%(cpoint)=%(in_font)", "U+43..U+44"}, "U+0043=yes U+0044=no"},
+		{[]string{"test.woff", "-c", "-f", "%(cpoint)=%(in_font)", "U+43..U+44"}, "U+0043=yes U+0044=no"},
+		{[]string{"test.woff2", "-c", "-f", "%(cpoint)=%(in_font)", "U+43..U+44"}, "U+0043=yes U+0044=no"},
+		{[]string{"test.ttf", "U+41..U+44"}, "In font Name " +
+			"'A' U+0041 yes LATIN CAPITAL LETTER A " +
+			"'B' U+0042 yes LATIN CAPITAL LETTER B " +
+			"'C' U+0043 yes LATIN CAPITAL LETTER C " +
+			"'D' U+0044 no LATIN CAPITAL LETTER D " +
+			"test.ttf covers 3 of 4 codepoints (75%)"},
+		{[]string{"test.ttf", "-as", "table", "-c", "U+41..U+44"},
+			"0 1 2 3 4 5 6 7 8 9 A B C D E F ┌──────────────────────────────────────────────── " +
+				"U+004x │ · A B C ·"},
+		{[]string{"test.ttf", "-c", "-f", "%(emoji) %(in_font)", "emoji:n:red heart"}, "❤️ yes"},
+		{[]string{"test.ttf", "emoji:g:smiling", "emoji:grinning"}, "In font Name " +
+			"😀 yes grinning face 😃 no grinning face with big eyes 😄 no grinning face with smiling eyes " +
+			"😁 no beaming face with smiling eyes 😆 no grinning squinting face 😅 no grinning face with sweat " +
+			"test.ttf covers 1 of 6 emojis (16%)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, " "), func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"uni", "font", fonts[tt.in[0]]}, tt.in[1:]...)
+			main()
+
+			if have := strings.Join(strings.Fields(outbuf.String()), " "); have != tt.want {
+				t.Errorf("wrong output\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+
+	// Table sizes from the header that are too large.
+	for _, f := range []string{"test-big.woff", "test-big.woff2"} {
+		t.Run(f, func(t *testing.T) {
+			exit, _, outbuf := zli.Test(t)
+			os.Args = []string{"uni", "font", fonts[f]}
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if have := outbuf.String(); *exit != 1 || !strings.Contains(have, "truncated or corrupt font file") {
+				t.Errorf("wrong output: %d %q", *exit, have)
+			}
+		})
+	}
+}
+
+// Write fonts with just a cmap table that maps the codepoints to glyphs; the
+// "-fmt4" variant has only a format 4 subtable, and the rest a format 12 one.
+func writeTestFonts(t *testing.T, cps ...rune) map[string]string {
+	t.Helper()
+	u16 := func(b []byte, n int) []byte { return binary.BigEndian.AppendUint16(b, uint16(n)) }
+	u32 := func(b []byte, n int) []byte { return binary.BigEndian.AppendUint32(b, uint32(n)) }
+
+	// Format 4, with a segment for every BMP codepoint and the final 0xffff
+	// segment.
+	var bmp []rune
+	for _, c := range cps {
+		if c <= 0xffff {
+			bmp = append(bmp, c)
+		}
+	}
+	bmp = append(bmp, 0xffff)
+	var ends, starts, deltas, ranges []byte
+	for i, c := range bmp {
+		ends, starts, deltas, ranges = u16(ends, int(c)), u16(starts, int(c)), u16(deltas, i+1-int(c)), u16(ranges, 0)
+	}
+	fmt4 := u16(u16(u16(nil, 4), 16+len(bmp)*8), 0)
+	fmt4 = u16(u16(u16(u16(fmt4, len(bmp)*2), 0), 0), 0)
+	fmt4 = append(append(append(u16(append(fmt4, ends...), 0), starts...), deltas...), ranges...)
+
+	// Format 12, with a group for every codepoint.
+	fmt12 := u32(u32(u32(u16(u16(nil, 12), 0), 16+len(cps)*12), 0), len(cps))
+	for i, c := range cps {
+		fmt12 = u32(u32(u32(fmt12, int(c)), int(c)), i+1)
+	}
+
+	cmap := func(platform, encoding int, sub []byte) []byte {
+		return append(u32(u16(u16(u16(u16(nil, 0), 1), platform), encoding), 12), sub...)
+	}
+	sfnt := func(cmap []byte, base int) []byte {
+		b := u16(u16(u16(u16(u32(nil, 0x00010000), 1), 16), 0), 0)
+		b = u32(u32(u32(append(b, "cmap"...), 0), base+12+16), len(cmap))
+		return append(b, cmap...)
+	}
+
+	var (
+		fmt4Cmap  = cmap(3, 1, fmt4)
+		fmt12Cmap = cmap(3, 10, fmt12)
+		fonts     = map[string][]byte{
+			"test-fmt4.ttf": sfnt(fmt4Cmap, 0),
+			"test.ttf":      sfnt(fmt12Cmap, 0),
+			"test.ttc":      append(u32(u32(u32(append([]byte(nil), "ttcf"...), 0x00010000), 1), 16), sfnt(fmt12Cmap, 16)...),
+		}
+	)
+
+	{ // WOFF 1.0 with a zlib-compressed cmap.
+		z := new(bytes.Buffer)
+		zw := zlib.NewWriter(z)
+		zw.Write(fmt12Cmap)
+		zw.Close()
+		b := u16(u16(u32(append([]byte("wOFF"), 0, 1, 0, 0), 44+20+z.Len()), 1), 0)
+		b = u16(u16(u32(b, 12+16+len(fmt12Cmap)), 1), 0)
+		b = u32(u32(u32(u32(u32(b, 0), 0), 0), 0), 0)
+		b = u32(u32(u32(u32(append(b, "cmap"...), 44+20), z.Len()), len(fmt12Cmap)), 0)
+		fonts["test.woff"] = append(b, z.Bytes()...)
+
+		big := append([]byte(nil), fonts["test.woff"]...)
+		binary.BigEndian.PutUint32(big[44+12:], 0xffffffff)
+		fonts["test-big.woff"] = big
+	}
+	{ // WOFF 2.0, with a table with an explicit tag before the cmap.
+		br := new(bytes.Buffer)
+		bw := brotli.NewWriter(br)
+		bw.Write([]byte("1234"))
+		bw.Write(fmt12Cmap)
+		bw.Close()
+		b := u16(u16(u32(append([]byte("wOF2"), 0, 1, 0, 0), 0), 2), 0)
+		b = u16(u16(u32(u32(b, 12+32+4+len(fmt12Cmap)), br.Len()), 1), 0)
+		b = u32(u32(u32(u32(u32(b, 0), 0), 0), 0), 0)
+		b = append(b, 0x3f, 't', 'e', 's', 't', 4, 0x00, byte(len(fmt12Cmap)))
+		b = append(b, br.Bytes()...)
+		binary.BigEndian.PutUint32(b[8:], uint32(len(b)))
+		fonts["test.woff2"] = b
+
+		big := append([]byte(nil), b[:48]...)
+		big = append(big, 0x3f, 't', 'e', 's', 't', 0x8f, 0xff, 0xff, 0xff, 0x7f, 0x00, byte(len(fmt12Cmap)))
+		fonts["test-big.woff2"] = append(big, b[48+8:]...)
+	}
+
+	tmp := t.TempDir()
+	paths := make(map[string]string)
+	for name, data := range fonts {
+		paths[name] = filepath.Join(tmp, name)
+		if err := os.WriteFile(paths[name], data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return paths
+}
+
+type fakeTTY struct {
+	written  bytes.Buffer
+	response *strings.Reader
+}
+
+func (f *fakeTTY) Write(b []byte) (int, error) { return f.written.Write(b) }
+func (f *fakeTTY) Read(b []byte) (int, error)  { return f.response.Read(b) }
+
+func TestTermwidth(t *testing.T) {
+	t.Run("probe", func(t *testing.T) {
+		tests := []struct {
+			resp string
+			want int
+		}{
+			{"\x1b[3;5R", 4},
+			{"x\x1b[12;1R", 0}, // Other input before the response.
+			{"\x1b[3R", -1},
+		}
+		for _, tt := range tests {
+			tty := &fakeTTY{response: strings.NewReader(tt.resp)}
+			have, err := probeWidth(tty, "€")
+			if tt.want == -1 {
+				if err == nil {
+					t.Errorf("no error for %q", tt.resp)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != tt.want {
+				t.Errorf("have %d; want %d", have, tt.want)
+			}
+			if w := tty.written.String(); w != "\r€\x1b[6n\r\x1b[K" {
+				t.Errorf("wrong written: %q", w)
+			}
+		}
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		t.Cleanup(func() { widthOverrides = nil })
+		tmp := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmp)
+		t.Setenv("TERM_PROGRAM", "test")
+		os.MkdirAll(filepath.Join(tmp, "uni", "widths"), 0o755)
+		err := os.WriteFile(filepath.Join(tmp, "uni", "widths", "test"),
+			[]byte("# Comment\nU+20AC 2\nU+1F408 U+200D U+2B1B 4\n"), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, outbuf := zli.Test(t)
+		os.Args = []string{"uni", "i", "-c", "-f", "%(char q h l:3)%(wide_padding)|", "€a"}
+		main()
+		want := "'€'|\n'a' |\n"
+		if have := outbuf.String(); have != want {
+			t.Errorf("\nhave: %q\nwant: %q", have, want)
+		}
+		if w := textWidth("a\U0001f408\u200d\u2b1bb€"); w != 8 {
+			t.Errorf("textWidth: %d", w)
+		}
+	})
+
+	t.Run("variation selectors", func(t *testing.T) {
+		tests := []struct {
+			in   string
+			want int
+		}{
+			{"\u263a", 1},
+			{"\u263a\ufe0f", 2},
+			{"\U0001f600\ufe0e", 1},
+			{"#\ufe0f\u20e3", 2},
+			{"e\u0301", 1},
+		}
+		for _, tt := range tests {
+			if have := textWidth(tt.in); have != tt.want {
+				t.Errorf("textWidth(%q) = %d; want %d", tt.in, have, tt.want)
+			}
+		}
+	})
+}
+
+func TestWidthModel(t *testing.T) {
+	t.Cleanup(func() { widthModel = unidata.WidthModelUnicode })
+	tests := []struct {
+		model string
+		want  string
+	}{
+		{"unicode", "'😀'|2 '─' |1 'é' |1 '\x01'  |0 'ᅠ' |1"},
+		{"ambiguous-wide", "'😀'|2 '─'|2 'é'|2 '\x01'  |0 'ᅠ' |1"},
+		{"glibc-wcwidth", "'😀'|2 '─' |1 'é' |1 '\x01'  |-1 'ᅠ'  |0"},
+		{"emoji-narrow", "'😀' |1 '─' |1 'é' |1 '\x01'  |0 'ᅠ' |1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			_, _, outbuf := zli.Test(t)
+			os.Args = []string{"uni", "i", "-c", "-r", "-width-model", tt.model,
+				"-f", "%(char q h l:3)%(wide_padding)|%(cells)", "😀─é\x01\u1160"}
+			main()
+
+			if have := strings.Join(strings.Split(strings.TrimSpace(outbuf.String()), "\n"), " "); have != tt.want {
+				t.Errorf("\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestLSP(t *testing.T) {
+	type msg = map[string]any
+	run := func(t *testing.T, msgs ...msg) (int, []json.RawMessage) {
+		t.Helper()
+		exit, in, out := zli.Test(t)
+		for _, m := range msgs {
+			m["jsonrpc"] = "2.0"
+			j, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fmt.Fprintf(in, "Content-Length: %d\r\n\r\n%s", len(j), j)
+		}
+		os.Args = []string{"uni", "lsp"}
+		func() {
+			defer exit.Recover()
+			main()
+		}()
+
+		var resp []json.RawMessage
+		for _, part := range regexp.MustCompile(`Content-Length: \d+\r\n\r\n`).Split(out.String(), -1)[1:] {
+			resp = append(resp, json.RawMessage(part))
+		}
+		return int(*exit), resp
+	}
+
+	var (
+		uri  = "file:///x.go"
+		text = "s := \"pаypal\u202e\u200b\"\n:thumbsu \\euro_s\n👨\u200d👩\u200d👧 e\u0301 раураӏ"
+		open = msg{"method": "textDocument/didOpen", "params": msg{"textDocument": msg{
+			"uri": uri, "languageId": "go", "version": 1, "text": text}}}
+		pos = func(line, char int) msg { return msg{"line": line, "character": char} }
+		doc = msg{"uri": uri}
+	)
+	exit, resp := run(t,
+		msg{"id": 1, "method": "initialize", "params": msg{}},
+		open,
+		msg{"id": 2, "method": "textDocument/hover", "params": msg{"textDocument": doc, "position": pos(0, 7)}},
+		msg{"id": 3, "method": "textDocument/codeAction", "params": msg{"textDocument": doc,
+			"range": msg{"start": pos(0, 12), "end": pos(0, 13)}, "context": msg{"diagnostics": []any{}}}},
+		msg{"id": 4, "method": "textDocument/completion", "params": msg{"textDocument": doc, "position": pos(1, 8)}},
+		msg{"id": 5, "method": "textDocument/completion", "params": msg{"textDocument": doc, "position": pos(1, 16)}},
+		msg{"id": 6, "method": "nope", "params": msg{}},
+		msg{"id": 7, "method": "shutdown"},
+		msg{"method": "exit"},
+	)
+	if exit != -1 {
+		t.Fatalf("exit %d", exit)
+	}
+	if len(resp) != 8 {
+		t.Fatalf("want 8 messages, have %d:\n%s", len(resp), resp)
+	}
+
+	get := func(i int, v any) {
+		t.Helper()
+		if err := json.Unmarshal(resp[i], v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("diagnostics", func(t *testing.T) {
+		var d struct {
+			Params struct {
+				Diagnostics []lspDiagnostic `json:"diagnostics"`
+			} `json:"params"`
+		}
+		get(1, &d)
+		var have []string
+		for _, diag := range d.Params.Diagnostics {
+			have = append(have, fmt.Sprintf("%d:%d %s %s", diag.Range.Start.Line, diag.Range.Start.Character, diag.Code, diag.Message))
+		}
+		want := []string{
+			"0:12 bidi U+202E RIGHT-TO-LEFT OVERRIDE: bidirectional control character; the text may display differently than it's read",
+			"0:13 invisible U+200B ZERO WIDTH SPACE: invisible character",
+			"0:7 mixed-script U+0430 CYRILLIC SMALL LETTER A: Cyrillic character in a Latin word; this may be confused with a Latin character",
+			`2:12 whole-script Cyrillic word that looks like the Latin "paypal"`,
+		}
+		if !reflect.DeepEqual(have, want) {
+			t.Errorf("\nhave:\n%s\nwant:\n%s", strings.Join(have, "\n"), strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		var h struct {
+			Result struct {
+				Contents struct {
+					Value string `json:"value"`
+				} `json:"contents"`
+			} `json:"result"`
+		}
+		get(2, &h)
+		for _, w := range []string{"`а` U+0430 CYRILLIC SMALL LETTER A\n", "\nScript        Cyrillic\n", "\nASCII         a\n"} {
+			if !strings.Contains(h.Result.Contents.Value, w) {
+				t.Errorf("%q not in:\n%s", w, h.Result.Contents.Value)
+			}
+		}
+	})
+
+	t.Run("codeAction", func(t *testing.T) {
+		var a struct {
+			Result []lspCodeAction `json:"result"`
+		}
+		get(3, &a)
+		var have []string
+		for _, ca := range a.Result {
+			e := ca.Edit.Changes[uri][0]
+			have = append(have, fmt.Sprintf("%s %s %d:%d %q", ca.Kind, ca.Title, e.Range.Start.Line, e.Range.Start.Character, e.NewText))
+		}
+		want := []string{
+			`quickfix Remove U+202E RIGHT-TO-LEFT OVERRIDE 0:12 ""`,
+			`refactor.rewrite Replace U+202E RIGHT-TO-LEFT OVERRIDE with \u202e 0:12 "\\u202e"`,
+		}
+		if !reflect.DeepEqual(have, want) {
+			t.Errorf("\nhave:\n%s\nwant:\n%s", strings.Join(have, "\n"), strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		for i, want := range []string{":thumbsup 👍 1:0-1:8", `\euro_sign € 1:9-1:16`} {
+			var c struct {
+				Result struct {
+					Items []lspCompletionItem `json:"items"`
+				} `json:"result"`
+			}
+			get(4+i, &c)
+			if len(c.Result.Items) == 0 {
+				t.Fatalf("no items: %s", resp[4+i])
+			}
+			it := c.Result.Items[0]
+			r := it.TextEdit.Range
+			have := fmt.Sprintf("%s %s %d:%d-%d:%d", it.Label, it.TextEdit.NewText, r.Start.Line, r.Start.Character, r.End.Line, r.End.Character)
+			if have != want {
+				t.Errorf("\nhave: %s\nwant: %s", have, want)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if have, want := string(resp[6]), `{"error":{"code":-32601,"message":"method not supported: \"nope\""},"id":6,"jsonrpc":"2.0"}`; have != want {
+			t.Errorf("\nhave: %s\nwant: %s", have, want)
+		}
+		if have, want := string(resp[7]), `{"id":7,"jsonrpc":"2.0","result":null}`; have != want {
+			t.Errorf("\nhave: %s\nwant: %s", have, want)
+		}
+
+		exit, resp := run(t, open, msg{"method": "exit"})
+		if exit != 1 || len(resp) != 1 {
+			t.Errorf("exit without shutdown: exit %d: %s", exit, resp)
+		}
+
+		// Invalid JSON is an error, but doesn't stop the server.
+		exit2, in, out := zli.Test(t)
+		fmt.Fprintf(in, "Content-Length: 5\r\n\r\n{nope")
+		fmt.Fprintf(in, "Content-Length: 37\r\n\r\n"+`{"jsonrpc":"2.0","id":1,"method":"x"}`)
+		os.Args = []string{"uni", "lsp"}
+		func() {
+			defer exit2.Recover()
+			main()
+		}()
+		if !strings.HasPrefix(out.String(), "Content-Length: ") ||
+			!strings.Contains(out.String(), `{"error":{"code":-32700,"message":"invalid message: invalid character 'n' looking for beginning of object key string"},"id":null,"jsonrpc":"2.0"}`) ||
+			!strings.Contains(out.String(), `{"error":{"code":-32601,"message":"method not supported: \"x\""},"id":1,"jsonrpc":"2.0"}`) {
+			t.Errorf("wrong output for invalid JSON:\n%s", out.String())
+		}
+	})
+}
+
 func BenchmarkUni(b *testing.B) {
 	zli.Stdout = new(bytes.Buffer)
 
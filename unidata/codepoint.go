@@ -3,6 +3,7 @@ package unidata
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode/utf16"
@@ -51,6 +52,10 @@ func (b Block) String() string    { return Blocks[b].Name }
 func (s Script) String() string   { return Scripts[s].Name }
 func (p Property) String() string { return Properties[p].Name }
 func (u Unicode) String() string  { return Unicodes[u].Name }
+
+// Code gets the ISO 15924 code, such as "Latn" for Latin.
+func (s Script) Code() string { return scriptCodes[s] }
+
 func (p PropertyList) String() string {
 	var b strings.Builder
 	for i, pp := range p {
@@ -326,6 +331,7 @@ func (c Codepoint) Properties() PropertyList {
 			}
 		}
 	}
+	slices.Sort(all) // Map order is random.
 	return all
 }
 
@@ -410,8 +416,8 @@ func (c Codepoint) XML() string { return "&#x" + strconv.FormatInt(int64(c.Codep
 // HTML formats the codepoint as an HTML entity, prefering a symbolic name if it
 // exists (e.g. &amp; instead of &#x26;)
 func (c Codepoint) HTML() string {
-	if h := htmlEntities[c.Codepoint]; h != "" {
-		return "&" + h + ";"
+	if h := htmlEntities[string(c.Codepoint)]; len(h) > 0 {
+		return "&" + h[0] + ";"
 	}
 	return c.XML()
 }
@@ -0,0 +1,217 @@
+package unidata
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CollationStrength is the number of levels to compare when collating.
+type CollationStrength uint8
+
+func (s CollationStrength) String() string { return CollationStrengths[s] }
+
+// Collation strengths.
+const (
+	CollationPrimary    = CollationStrength(iota + 1) // Base letters only.
+	CollationSecondary                                // Also accents.
+	CollationTertiary                                 // Also case; the default.
+	CollationQuaternary                               // Also variable characters if Shifted is set.
+	CollationIdentical                                // Also the codepoints.
+)
+
+// CollationStrengths is a list of all collation strengths.
+var CollationStrengths = map[CollationStrength]string{
+	CollationPrimary:    "primary",
+	CollationSecondary:  "secondary",
+	CollationTertiary:   "tertiary",
+	CollationQuaternary: "quaternary",
+	CollationIdentical:  "identical",
+}
+
+// collElem is a collation element: primary<<32 | secondary<<16 | tertiary<<8,
+// with the lowest bit set for variable elements (spaces, punctuation, and
+// most symbols).
+type collElem uint64
+
+func (e collElem) primary() uint16   { return uint16(e >> 32) }
+func (e collElem) secondary() uint16 { return uint16(e >> 16) }
+func (e collElem) tertiary() uint16  { return uint16(e>>8) & 0xff }
+func (e collElem) variable() bool    { return e&1 == 1 }
+
+// Collator compares strings with the Unicode Collation Algorithm (UTS #10),
+// using the root collation; see CollationVersion for the version.
+//
+// The zero value compares with CollationTertiary, and variable characters
+// are not ignored.
+type Collator struct {
+	Strength CollationStrength
+
+	// Shift variable collation elements to the quaternary level, so that
+	// spaces and punctuation are ignored unless the strength is quaternary or
+	// identical ("alternate=shifted").
+	Shifted bool
+}
+
+// Compare a and b, returning -1, 0, or 1.
+func (c Collator) Compare(a, b string) int {
+	return bytes.Compare(c.Key(a), c.Key(b))
+}
+
+// Key gets the sort key for s; keys can be compared with bytes.Compare().
+//
+// Every weight is two bytes, and the levels are separated by two zero bytes.
+func (c Collator) Key(s string) []byte {
+	s = norm.NFD.String(s)
+	elems := collElems(s)
+
+	var levels int
+	switch c.Strength {
+	case CollationPrimary:
+		levels = 1
+	case CollationSecondary:
+		levels = 2
+	case 0, CollationTertiary:
+		levels = 3
+	default:
+		levels = 3
+		if c.Shifted {
+			levels = 4
+		}
+	}
+
+	/// Build the weights for every level; the fourth level is only used for
+	/// shifted.
+	var (
+		w             = make([][4]uint16, 0, len(elems))
+		afterVariable bool
+	)
+	for _, e := range elems {
+		p, sec, t := e.primary(), e.secondary(), e.tertiary()
+		if !c.Shifted {
+			w = append(w, [4]uint16{p, sec, t})
+			continue
+		}
+		switch {
+		case p == 0 && sec == 0 && t == 0: /// Completely ignorable.
+			w = append(w, [4]uint16{})
+		case e.variable():
+			w, afterVariable = append(w, [4]uint16{0, 0, 0, p}), true
+		case p == 0 && afterVariable:
+			w = append(w, [4]uint16{})
+		case p == 0:
+			w = append(w, [4]uint16{0, sec, t, 0xffff})
+		case sec == 0 && t == 0: /// Second part of an implicit weight.
+			w = append(w, [4]uint16{p, 0, 0, 0})
+		default:
+			w, afterVariable = append(w, [4]uint16{p, sec, t, 0xffff}), false
+		}
+	}
+
+	key := make([]byte, 0, len(w)*2*levels+len(s))
+	for l := 0; l < levels; l++ {
+		if l > 0 {
+			key = append(key, 0, 0)
+		}
+		for _, ww := range w {
+			if ww[l] != 0 {
+				key = binary.BigEndian.AppendUint16(key, ww[l])
+			}
+		}
+	}
+	if c.Strength == CollationIdentical {
+		key = append(append(key, 0, 0), s...)
+	}
+	return key
+}
+
+// collElems gets the collation elements for the NFD string s.
+func collElems(s string) []collElem {
+	var (
+		r     = []rune(s)
+		elems = make([]collElem, 0, len(r))
+	)
+	for i := 0; i < len(r); {
+		/// Longest contraction starting at i.
+		var (
+			ce []collElem
+			n  int
+		)
+		for n = min(collationMaxLen, len(r)-i); n > 1; n-- {
+			if idx, ok := collationContractions[string(r[i:i+n])]; ok {
+				ce = collTable(idx)
+				break
+			}
+		}
+		if n <= 1 {
+			idx, ok := collationElements[r[i]]
+			if !ok {
+				elems = append(elems, implicitWeights(r[i])...)
+				i++
+				continue
+			}
+			n, ce = 1, collTable(idx)
+		}
+
+		/// Discontiguous contractions: an unblocked combining character after
+		/// the match that forms a longer contraction with it, e.g. "и" U+0323
+		/// U+0306 is "й" followed by U+0323.
+		key := string(r[i : i+n])
+		var skipped uint8
+		for j := i + n; j < len(r); {
+			ccc := norm.NFD.PropertiesString(string(r[j])).CCC()
+			if ccc == 0 {
+				break
+			}
+			if skipped == 0 || ccc > skipped {
+				if idx, ok := collationContractions[key+string(r[j])]; ok {
+					key, ce = key+string(r[j]), collTable(idx)
+					r = append(r[:j], r[j+1:]...)
+					continue
+				}
+			}
+			skipped = max(skipped, ccc)
+			j++
+		}
+
+		elems = append(elems, ce...)
+		i += n
+	}
+	return elems
+}
+
+// collTable gets the collation elements from an offset<<8 | length index.
+func collTable(idx uint32) []collElem {
+	return collationTable[idx>>8 : idx>>8+idx&0xff]
+}
+
+// implicitWeights gets the collation elements for codepoints that aren't in
+// the table, as described in UTS #10 section 10.1.
+func implicitWeights(r rune) []collElem {
+	var aaaa, bbbb uint16
+	for _, im := range collationImplicit {
+		if r >= im.rng[0] && r <= im.rng[1] {
+			aaaa, bbbb = im.base, uint16(r-im.first)|0x8000
+			break
+		}
+	}
+	if aaaa == 0 {
+		aaaa, bbbb = 0xfbc0+uint16(r>>15), uint16(r&0x7fff)|0x8000
+		for _, rng := range Properties[PropUnifiedIdeograph].Ranges {
+			if r >= rng[0] && r <= rng[1] {
+				/// The CJK Unified Ideographs and CJK Compatibility Ideographs
+				/// blocks sort before the extensions.
+				aaaa -= 0x40
+				if (r >= 0x4e00 && r <= 0x9fff) || (r >= 0xf900 && r <= 0xfaff) {
+					aaaa -= 0x40
+				}
+				break
+			}
+		}
+	}
+	return []collElem{
+		collElem(aaaa)<<32 | 0x20<<16 | 0x02<<8,
+		collElem(bbbb) << 32,
+	}
+}
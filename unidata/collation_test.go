@@ -0,0 +1,75 @@
+package unidata
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCollatorKey(t *testing.T) {
+	tests := []struct {
+		in                string
+		want, wantShifted string
+	}{
+		{"a", "1fa20000002000000002", "1fa200000020000000020000ffff"},
+		{"A", "1fa20000002000000008", "1fa200000020000000080000ffff"},
+		{"á", "1fa2000000200024000000020002", "1fa20000002000240000000200020000ffffffff"},
+		{"a b", "1fa202091fbc00000020002000200000000200020002", "1fa21fbc0000002000200000000200020000ffff0209ffff"},
+		{"l·", "20d6000000200118000000020002", "20d60000002001180000000200020000ffffffff"},
+
+		// Contraction, and discontiguous contraction.
+		{"й", "23f20000002000000002", "23f200000020000000020000ffff"},
+		{"й̣", "23f2000000200042000000020002", "23f20000002000420000000200020000ffffffff"},
+
+		// Hangul is decomposed to jamo.
+		{"가", "417541f3000000200020000000020002", "417541f30000002000200000000200020000ffffffff"},
+
+		// Implicit weights: Han, Tangut, and unassigned.
+		{"一", "fb40ce000000002000000002", "fb40ce0000000020000000020000ffff"},
+		{"㐀", "fb80b4000000002000000002", "fb80b40000000020000000020000ffff"},
+		{"\U00017001", "fb0080010000002000000002", "fb00800100000020000000020000ffff"},
+		{"\U00018d00", "fb009d000000002000000002", "fb009d0000000020000000020000ffff"},
+		{"\U00050000", "fbca80000000002000000002", "fbca800000000020000000020000ffff"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			have := fmt.Sprintf("%x", Collator{}.Key(tt.in))
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+			have = fmt.Sprintf("%x", Collator{Strength: CollationQuaternary, Shifted: true}.Key(tt.in))
+			if have != tt.wantShifted {
+				t.Errorf("shifted\nhave: %s\nwant: %s", have, tt.wantShifted)
+			}
+		})
+	}
+}
+
+func TestCollatorCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		strength CollationStrength
+		shifted  bool
+		want     int
+	}{
+		{"a", "b", 0, false, -1},
+		{"a", "A", 0, false, -1},
+		{"a", "A", CollationSecondary, false, 0},
+		{"a", "á", CollationSecondary, false, -1},
+		{"a", "á", CollationPrimary, false, 0},
+		{"ä", "ä", CollationIdentical, false, 0},
+		{"de luge", "deluge", 0, false, -1},
+		{"de luge", "deluge", 0, true, 0},
+		{"de luge", "deluge", CollationQuaternary, true, -1},
+		{"\U0001f951", "a", 0, false, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			have := Collator{Strength: tt.strength, Shifted: tt.shifted}.Compare(tt.a, tt.b)
+			if have != tt.want {
+				t.Errorf("have: %d; want: %d", have, tt.want)
+			}
+		})
+	}
+}
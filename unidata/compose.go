@@ -0,0 +1,101 @@
+package unidata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	composeByRune     map[rune][]string
+	composeByRuneOnce sync.Once
+)
+
+// Compose gets the X11 Compose sequences for this codepoint, as the keys typed
+// after the Compose key (Multi_key); for example "C=" and "=C" for €.
+//
+// This uses the default libX11 en_US.UTF-8 Compose file, and only includes
+// sequences where all keys are characters (that is, no keypad or dead keys).
+// The first sequence is the first one listed in the file.
+func (c Codepoint) Compose() []string {
+	composeByRuneOnce.Do(func() {
+		composeByRune = make(map[rune][]string)
+		for _, s := range composeSeqs {
+			if r := []rune(s.out); len(r) == 1 {
+				composeByRune[r[0]] = append(composeByRune[r[0]], s.keys)
+			}
+		}
+	})
+	return composeByRune[c.Codepoint]
+}
+
+func (c Codepoint) composeFormatted() []string {
+	seqs := c.Compose()
+	l := make([]string, 0, len(seqs))
+	for _, s := range seqs {
+		l = append(l, FormatCompose(s))
+	}
+	return l
+}
+
+// ComposeKey gets the keysym name to use for a key in a Compose file, such as
+// "equal" for "=".
+func ComposeKey(r rune) string {
+	if k, ok := composeKeys[r]; ok {
+		return k
+	}
+	if k := keysyms[r]; k != "" {
+		return k
+	}
+	return fmt.Sprintf("U%04X", r)
+}
+
+// FindComposeKey finds the character for a keysym name in a Compose sequence,
+// such as "=" for "equal".
+func FindComposeKey(name string) (rune, bool) {
+	for r, k := range composeKeys {
+		if k == name {
+			return r, true
+		}
+	}
+	if r, ok := FindKeySym(name); ok {
+		return r, true
+	}
+	if len(name) > 1 && name[0] == 'U' {
+		if r, err := strconv.ParseUint(name[1:], 16, 32); err == nil {
+			return rune(r), true
+		}
+	}
+	return 0, false
+}
+
+// FormatCompose formats a Compose sequence as it's written in a Compose file,
+// for example "<Multi_key> <C> <equal>" for "C=".
+func FormatCompose(keys string) string {
+	var b strings.Builder
+	b.WriteString("<Multi_key>")
+	for _, r := range keys {
+		b.WriteString(" <" + ComposeKey(r) + ">")
+	}
+	return b.String()
+}
+
+// ComposeConflicts reports if a new Compose sequence would conflict with the
+// default Compose file: a sequence can't be the start of another sequence.
+func ComposeConflicts(keys string) bool {
+	if keys == "" {
+		return true
+	}
+	for _, s := range composeSeqs {
+		if strings.HasPrefix(s.keys, keys) || strings.HasPrefix(keys, s.keys) {
+			return true
+		}
+	}
+	for _, p := range composeOther {
+		if strings.HasPrefix(p, keys) {
+			return true
+		}
+	}
+	return false
+}
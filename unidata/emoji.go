@@ -30,6 +30,18 @@ func (e Emoji) Subgroup() EmojiSubgroup { return e.subgroup }
 func (e Emoji) Skintones() bool         { return e.skinTones }
 func (e Emoji) Genders() bool           { return e.gender > 0 }
 
+// Keywords gets the CLDR names without the ones that are already in the emoji
+// name.
+func (e Emoji) Keywords() []string {
+	kw := make([]string, 0, len(e.CLDR))
+	for _, c := range e.CLDR {
+		if !strings.Contains(e.Name, c) {
+			kw = append(kw, c)
+		}
+	}
+	return kw
+}
+
 func (e Emoji) String() string {
 	if len(e.Codepoints) == 0 { // Should never happen.
 		return ""
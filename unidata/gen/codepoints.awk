@@ -41,36 +41,6 @@ END {
 
     print("var Codepoints = map[rune]Codepoint{\n" codepoints "\n}\n")
 
-    print("var htmlEntities = map[rune]string{")
-    while (getline line <".cache/entities.json" > 0) {
-        split(line, fields, /["\[\]]/)
-        ent = fields[2]
-        if (match(fields[6], ", ")) # Exclude multi-codepoint entities
-            continue
-        cp  = strtonum(fields[6])
-        if (ent == "" || index(ent, ";") == 0)  # Don't include legacy w/o ;
-            continue
-        ent = gensub("^&|;$", "", "g", ent)
-
-        # Prefer entities without capitals and shorter entities.
-        # TODO: include all entities as a []string; select the first one with
-        # "%(html)" and all of them with "%(htmls)", "%(html_all)" or something.
-        if (!(cp in all_ent) || (match(all_ent[cp], /^[A-Z]/) && !match(ent, /^[A-Z]/)) || length(all_ent[cp]) > length(ent))
-            all_ent[cp] = ent
-    }
-    for (k in all_ent) printf("\t0x%02x: \"%s\",\n", k, all_ent[k])
-    print("}\n")
-
-    print("var keysyms = map[rune]string{")
-    while (getline line <".cache/keysymdef.h" > 0) {
-        if (match(line, "^#define XK") == 0)
-            continue
-        split(line, fields, " ")
-        all_sym[strtonum(fields[3])] = gensub("^XK_", "", 1, fields[2])
-    }
-    for (k in all_sym) printf("\t0x%02x: \"%s\",\n", k, all_sym[k])
-    print("}\n")
-
     print("var digraphs = map[rune]string{")
     while (getline line <".cache/rfc1345.txt" > 0) {
 		if (index(line, "ISO-IR-") > 0)
@@ -0,0 +1,160 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: collation.go [allkeys.txt or allkeys_CLDR.txt]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	type implicit struct {
+		start, end, first rune
+		base              uint64
+	}
+	var (
+		version      string
+		implicits    []implicit
+		singles      strings.Builder
+		contractions strings.Builder
+		table        strings.Builder
+		ntable       int
+		maxLen       int
+	)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if i := strings.IndexByte(line, '#'); i > -1 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@version"):
+			version = strings.TrimSpace(strings.TrimPrefix(line, "@version"))
+			continue
+		case strings.HasPrefix(line, "@implicitweights"):
+			/// @implicitweights 17000..18AFF; FB00
+			f := strings.Split(strings.TrimPrefix(line, "@implicitweights"), ";")
+			se := strings.SplitN(strings.TrimSpace(f[0]), "..", 2)
+			start, err := strconv.ParseUint(se[0], 16, 32)
+			zli.F(err)
+			end, err := strconv.ParseUint(se[1], 16, 32)
+			zli.F(err)
+			base, err := strconv.ParseUint(strings.TrimSpace(f[1]), 16, 16)
+			zli.F(err)
+
+			/// The second weight is the offset from the start of the first
+			/// range with this base, e.g. Tangut Supplement continues from
+			/// Tangut.
+			first := rune(start)
+			for _, im := range implicits {
+				if im.base == base {
+					first = im.first
+					break
+				}
+			}
+			implicits = append(implicits, implicit{rune(start), rune(end), first, base})
+			continue
+		case line[0] == '@':
+			continue
+		}
+
+		f := strings.SplitN(line, ";", 2)
+		if len(f) != 2 {
+			zli.Fatalf("invalid line: %q", line)
+		}
+
+		var key strings.Builder
+		cps := strings.Fields(f[0])
+		for _, cp := range cps {
+			r, err := strconv.ParseUint(cp, 16, 32)
+			zli.F(err)
+			key.WriteRune(rune(r))
+		}
+		maxLen = max(maxLen, len(cps))
+
+		/// [.1C47.0020.0002][*0209.0020.0002]; "*" marks variable elements.
+		var elems []string
+		for _, e := range strings.Split(strings.TrimSpace(f[1]), "]") {
+			e = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(e), "["))
+			if e == "" {
+				continue
+			}
+			variable := e[0] == '*'
+			w := strings.Split(e[1:], ".")
+			if len(w) < 3 {
+				zli.Fatalf("invalid collation element %q in line %q", e, line)
+			}
+			var ce uint64
+			for i, shift := range []uint64{32, 16, 8} {
+				n, err := strconv.ParseUint(w[i], 16, 16)
+				zli.F(err)
+				ce |= n << shift
+			}
+			if variable {
+				ce |= 1
+			}
+			elems = append(elems, fmt.Sprintf("0x%X", ce))
+		}
+
+		/// Store all elements in one array, and the offset and length in the
+		/// maps; this is much faster to compile than a map of slices.
+		if len(elems) > 0xff {
+			zli.Fatalf("too many collation elements in line %q", line)
+		}
+		idx := ntable<<8 | len(elems)
+		fmt.Fprintf(&table, "\t%s, // %s\n", strings.Join(elems, ", "), strings.Join(cps, " "))
+		ntable += len(elems)
+		if len(cps) == 1 {
+			fmt.Fprintf(&singles, "\t0x%s: 0x%X,\n", cps[0], idx)
+		} else {
+			fmt.Fprintf(&contractions, "\t%q: 0x%X,\n", key.String(), idx)
+		}
+	}
+	zli.F(scan.Err())
+	if version == "" {
+		zli.Fatalf("no @version in %s", os.Args[1])
+	}
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Printf("// CollationVersion is the version of the root collation data, from %s.\n", filepath.Base(os.Args[1]))
+	fmt.Printf("const CollationVersion = %q\n\n", version)
+	fmt.Printf("// Longest contraction in collationContractions, in codepoints.\nconst collationMaxLen = %d\n\n", maxLen)
+
+	fmt.Print("// Implicit weights for scripts that aren't listed in collationElements.\n" +
+		"var collationImplicit = []struct {\n" +
+		"\trng   [2]rune\n" +
+		"\tfirst rune\n" +
+		"\tbase  uint16\n" +
+		"}{\n")
+	for _, im := range implicits {
+		fmt.Printf("\t{[2]rune{0x%04X, 0x%04X}, 0x%04X, 0x%04X},\n", im.start, im.end, im.first, im.base)
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Collation elements for all entries; collationElements and\n" +
+		"// collationContractions have the offset<<8 | length in this table.\n" +
+		"var collationTable = [...]collElem{\n" + table.String() + "}\n\n")
+	fmt.Print("// Collation elements for single codepoints.\n" +
+		"var collationElements = map[rune]uint32{\n" + singles.String() + "}\n\n")
+	fmt.Print("// Collation elements for contractions.\n" +
+		"var collationContractions = map[string]uint32{\n" + contractions.String() + "}\n")
+}
@@ -0,0 +1,148 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"zgo.at/zli"
+)
+
+var (
+	reKeysym  = regexp.MustCompile(`^#define XK_(\w+)\s+0x[0-9a-fA-F]+\s*/\*[ (]*U\+([0-9A-F]{4,6})`)
+	reCompose = regexp.MustCompile(`^((?:<\w+>\s*)+):\s*("(?:[^"\\]|\\.)*")`)
+	reKey     = regexp.MustCompile(`<(\w+)>`)
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		zli.Fatalf("usage: compose.go [Compose.pre] [keysymdef.h]")
+	}
+
+	// Keysym name → character.
+	keysyms := make(map[string]rune)
+	fp, err := os.Open(os.Args[2])
+	zli.F(err)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		m := reKeysym.FindStringSubmatch(scan.Text())
+		if m == nil {
+			continue
+		}
+		r, err := strconv.ParseUint(m[2], 16, 32)
+		zli.F(err)
+		keysyms[m[1]] = rune(r)
+	}
+	zli.F(scan.Err())
+	fp.Close()
+	key := func(name string) (rune, bool) {
+		if r, ok := keysyms[name]; ok {
+			return r, true
+		}
+		if len(name) > 1 && name[0] == 'U' {
+			if r, err := strconv.ParseUint(name[1:], 16, 32); err == nil {
+				return rune(r), true
+			}
+		}
+		return 0, false
+	}
+
+	fp, err = os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	type seq struct{ keys, out string }
+	var (
+		seqs     []seq
+		names    = make(map[rune]string)
+		order    []rune
+		other    []string
+		seenPfx  = make(map[string]bool)
+		seenKeys = make(map[string]bool)
+	)
+	scan = bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if !strings.HasPrefix(line, "<Multi_key>") {
+			continue
+		}
+		m := reCompose.FindStringSubmatch(line)
+		if m == nil {
+			zli.Fatalf("invalid line: %q", line)
+		}
+		out, err := strconv.Unquote(m[2])
+		if err != nil {
+			zli.Fatalf("invalid string in %q: %s", line, err)
+		}
+
+		/// Sequences are stored as the characters typed after Multi_key;
+		/// sequences with keys that aren't characters (keypad or dead keys) are
+		/// left out, but their leading characters are kept to check for
+		/// conflicts.
+		var (
+			keys  strings.Builder
+			ok    = true
+			ksyms = reKey.FindAllStringSubmatch(m[1], -1)[1:]
+		)
+		for _, k := range ksyms {
+			r, isChar := key(k[1])
+			if !isChar {
+				ok = false
+				break
+			}
+			if _, seen := names[r]; !seen {
+				names[r] = k[1]
+				order = append(order, r)
+			}
+			keys.WriteRune(r)
+		}
+		if !ok {
+			if p := keys.String(); p != "" && !seenPfx[p] {
+				seenPfx[p] = true
+				other = append(other, p)
+			}
+			continue
+		}
+		if seenKeys[keys.String()] {
+			continue
+		}
+		seenKeys[keys.String()] = true
+		seqs = append(seqs, seq{keys.String(), out})
+	}
+	zli.F(scan.Err())
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+
+	fmt.Print("// Compose sequences from libX11's en_US.UTF-8 Compose file, as the\n" +
+		"// characters typed after Multi_key, in the order they appear in the file.\n" +
+		"var composeSeqs = []struct{ keys, out string }{\n")
+	for _, s := range seqs {
+		fmt.Printf("\t{%q, %q},\n", s.keys, s.out)
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Leading characters of Compose sequences with keys that aren't characters,\n" +
+		"// such as keypad or dead keys.\n" +
+		"var composeOther = []string{\n")
+	for _, p := range other {
+		fmt.Printf("\t%q,\n", p)
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Keysym names for the keys in Compose sequences, as used in the Compose file.\n" +
+		"var composeKeys = map[rune]string{\n")
+	for _, r := range order {
+		if utf8.RuneLen(r) == 1 {
+			fmt.Printf("\t%q: %q,\n", r, names[r])
+		} else {
+			fmt.Printf("\t0x%04X: %q,\n", r, names[r])
+		}
+	}
+	fmt.Print("}\n")
+}
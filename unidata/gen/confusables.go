@@ -0,0 +1,64 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: confusables.go [confusables.txt]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	var (
+		prototypes = make(map[rune]string)
+		order      []rune
+	)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if i := strings.IndexByte(line, '#'); i > -1 {
+			line = line[:i]
+		}
+		/// The file starts with a BOM.
+		line = strings.TrimPrefix(line, "\ufeff")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			zli.Fatalf("invalid line: %q", line)
+		}
+
+		src, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		zli.F(err)
+		var proto strings.Builder
+		for _, cp := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseUint(cp, 16, 32)
+			zli.F(err)
+			proto.WriteRune(rune(r))
+		}
+		prototypes[rune(src)] = proto.String()
+		order = append(order, rune(src))
+	}
+	zli.F(scan.Err())
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Print("// Prototypes from confusables.txt (UTS #39), for the skeleton.\n" +
+		"var confusables = map[rune]string{\n")
+	for _, r := range order {
+		fmt.Printf("\t0x%04X: %q,\n", r, prototypes[r])
+	}
+	fmt.Print("}\n")
+}
@@ -0,0 +1,69 @@
+//go:build generate
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"zgo.at/zli"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: entities.go [entities.json]")
+	}
+
+	fp, err := os.ReadFile(os.Args[1])
+	zli.F(err)
+	var entities map[string]struct {
+		Characters string `json:"characters"`
+	}
+	zli.F(json.Unmarshal(fp, &entities))
+
+	names := make(map[string][]string)
+	for ent, e := range entities {
+		/// Legacy entities without ; are always also listed with ;
+		if !strings.HasSuffix(ent, ";") {
+			continue
+		}
+		names[e.Characters] = append(names[e.Characters], strings.Trim(ent, "&;"))
+	}
+
+	chars := make([]string, 0, len(names))
+	for c, l := range names {
+		chars = append(chars, c)
+		/// Prefer entities without capitals, and then shorter entities.
+		slices.SortFunc(l, func(a, b string) int {
+			if ua, ub := unicode.IsUpper(rune(a[0])), unicode.IsUpper(rune(b[0])); ua != ub {
+				if ua {
+					return 1
+				}
+				return -1
+			}
+			if len(a) != len(b) {
+				return len(a) - len(b)
+			}
+			return strings.Compare(a, b)
+		})
+	}
+	slices.Sort(chars)
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Print("// HTML entities from the WHATWG list, by the characters they're for; the\n" +
+		"// first is the preferred one.\n" +
+		"var htmlEntities = map[string][]string{\n")
+	for _, c := range chars {
+		q := make([]string, 0, len(names[c]))
+		for _, n := range names[c] {
+			q = append(q, strconv.Quote(n))
+		}
+		fmt.Printf("\t%q: {%s},\n", c, strings.Join(q, ", "))
+	}
+	fmt.Print("}\n")
+}
@@ -0,0 +1,166 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+type rng [2]rune
+
+// readUCD reads a UCD-style file, calling fun for every line with the
+// codepoint range and the remaining fields.
+func readUCD(f string, fun func(r rng, fields []string)) {
+	fp, err := os.Open(f)
+	zli.F(err)
+	defer fp.Close()
+
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if i := strings.IndexByte(line, '#'); i > -1 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		se := strings.SplitN(fields[0], "..", 2)
+		start, err := strconv.ParseUint(se[0], 16, 32)
+		if err != nil { /// PropertyValueAliases.txt doesn't have codepoints.
+			fun(rng{-1, -1}, fields)
+			continue
+		}
+		end := start
+		if len(se) == 2 {
+			end, err = strconv.ParseUint(se[1], 16, 32)
+			zli.F(err)
+		}
+		fun(rng{rune(start), rune(end)}, fields[1:])
+	}
+	zli.F(scan.Err())
+}
+
+func main() {
+	if len(os.Args) != 5 {
+		zli.Fatalf("usage: idents.go [IdentifierStatus.txt] [IdentifierType.txt] [ScriptExtensions.txt] [PropertyValueAliases.txt]")
+	}
+
+	var allowed []rng
+	readUCD(os.Args[1], func(r rng, f []string) {
+		if f[0] == "Allowed" {
+			allowed = append(allowed, r)
+		}
+	})
+
+	types := make(map[string][]rng)
+	readUCD(os.Args[2], func(r rng, f []string) {
+		for _, t := range strings.Fields(f[0]) {
+			types[t] = append(types[t], r)
+		}
+	})
+
+	/// Script_Extensions uses the short names; map them to our constants.
+	var (
+		scripts = make(map[string]string)
+		codes   []string
+	)
+	readUCD(os.Args[4], func(_ rng, f []string) {
+		if len(f) >= 3 && f[0] == "sc" {
+			scripts[f[1]] = mkconst("Script", f[2])
+			codes = append(codes, f[1])
+		}
+	})
+	slices.SortFunc(codes, func(a, b string) int { return strings.Compare(scripts[a], scripts[b]) })
+	type scx struct {
+		r       rng
+		scripts []string
+	}
+	var ext []scx
+	readUCD(os.Args[3], func(r rng, f []string) {
+		s := strings.Fields(f[0])
+		for i := range s {
+			c, ok := scripts[s[i]]
+			if !ok {
+				zli.Fatalf("unknown script %q in Script_Extensions", s[i])
+			}
+			s[i] = c
+		}
+		ext = append(ext, scx{r, s})
+	})
+
+	names := make([]string, 0, len(types))
+	for k := range types {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+
+	fmt.Print("// Identifier types from UTS #39.\nconst (\n\tIDTypeUnknown = IDType(iota)\n")
+	for _, n := range names {
+		fmt.Printf("\t%s\n", mkconst("IDType", n))
+	}
+	fmt.Print(")\n\n")
+
+	fmt.Print("// IDTypes is a list of all identifier types.\n" +
+		"var IDTypes = map[IDType]struct {\n" +
+		"\tName   string\n" +
+		"\tRanges [][2]rune\n" +
+		"}{\n" +
+		"\tIDTypeUnknown: {\"Unknown\", nil},\n")
+	for _, n := range names {
+		fmt.Printf("\t%s: {%q, [][2]rune{\n", mkconst("IDType", n), n)
+		for _, r := range types[n] {
+			fmt.Printf("\t\t{0x%04X, 0x%04X},\n", r[0], r[1])
+		}
+		fmt.Print("\t}},\n")
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Codepoints with the identifier status \"Allowed\"; everything else is\n" +
+		"// \"Restricted\".\n" +
+		"var idAllowed = [][2]rune{\n")
+	for _, r := range allowed {
+		fmt.Printf("\t{0x%04X, 0x%04X},\n", r[0], r[1])
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Script_Extensions for codepoints that are used in more than one script.\n" +
+		"var scriptExtensions = []struct {\n" +
+		"\trng     [2]rune\n" +
+		"\tscripts []Script\n" +
+		"}{\n")
+	for _, e := range ext {
+		fmt.Printf("\t{[2]rune{0x%04X, 0x%04X}, []Script{%s}},\n", e.r[0], e.r[1], strings.Join(e.scripts, ", "))
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// ISO 15924 codes for scripts.\nvar scriptCodes = map[Script]string{\n")
+	for _, c := range codes {
+		/// Katakana_Or_Hiragana isn't used in Scripts.txt, so there is no
+		/// constant for it.
+		if scripts[c] == "ScriptKatakanaOrHiragana" {
+			continue
+		}
+		fmt.Printf("\t%s: %q,\n", scripts[c], c)
+	}
+	fmt.Print("}\n")
+}
+
+func mkconst(prefix, n string) string {
+	return prefix + strings.NewReplacer("_", "", " ", "", "-", "").Replace(n)
+}
@@ -0,0 +1,57 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"zgo.at/zli"
+)
+
+// The keysym value is only the codepoint for Latin-1, so use the "/* U+XXXX"
+// comment; approximate mappings are written as "/*(U+XXXX" and are skipped.
+var reKeysym = regexp.MustCompile(`^#define XK_(\w+)\s+0x[0-9a-fA-F]+\s*/\* U\+([0-9A-Fa-f]{4,6})`)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: keysyms.go [keysymdef.h]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	var (
+		keysyms = make(map[rune]string)
+		order   []rune
+	)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		m := reKeysym.FindStringSubmatch(scan.Text())
+		if m == nil {
+			continue
+		}
+		r, err := strconv.ParseUint(m[2], 16, 32)
+		zli.F(err)
+		/// The first name is the preferred one.
+		if _, ok := keysyms[rune(r)]; !ok {
+			keysyms[rune(r)] = m[1]
+			order = append(order, rune(r))
+		}
+	}
+	zli.F(scan.Err())
+	slices.Sort(order)
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Print("// X11 keysym names from keysymdef.h, by the codepoint.\n" +
+		"var keysyms = map[rune]string{\n")
+	for _, r := range order {
+		fmt.Printf("\t0x%02x: %q,\n", r, keysyms[r])
+	}
+	fmt.Print("}\n")
+}
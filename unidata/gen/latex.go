@@ -0,0 +1,84 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+var reLaTeXAlias = regexp.MustCompile(`(?:^|[\s,])=\s*(\\(?:[a-zA-Z]+(?:\{[^}]*\})?|[^a-zA-Z\s]))`)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: latex.go [unimathsymbols.txt]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	var (
+		names = make(map[rune][]string)
+		order []rune
+	)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		/// Nr^chars^LaTeX^unicode-math^requirements^category^class^comments
+		///
+		/// The chars field can be "^" itself, so remove it before splitting;
+		/// combining characters are prefixed with a space.
+		nr, rest, _ := strings.Cut(line, "^")
+		cp, err := strconv.ParseUint(nr, 16, 32)
+		zli.F(err)
+		rest = strings.TrimPrefix(strings.TrimPrefix(rest, " "), string(rune(cp)))
+		f := strings.Split(rest, "^")
+		if len(f) != 7 {
+			zli.Fatalf("invalid line: %q", line)
+		}
+		f = append([]string{nr}, f...)
+
+		/// The LaTeX name first, then the unicode-math name, and then any
+		/// aliases listed in the comments as "= \name".
+		l := []string{f[2], f[3]}
+		for _, m := range reLaTeXAlias.FindAllStringSubmatch(f[7], -1) {
+			l = append(l, m[1])
+		}
+		r := rune(cp)
+		for _, n := range l {
+			if !strings.HasPrefix(n, `\`) || slices.Contains(names[r], n) {
+				continue
+			}
+			if _, ok := names[r]; !ok {
+				order = append(order, r)
+			}
+			names[r] = append(names[r], n)
+		}
+	}
+	zli.F(scan.Err())
+	slices.Sort(order)
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Print("// LaTeX and unicode-math names, from unimathsymbols.txt.\n" +
+		"var latexNames = map[rune][]string{\n")
+	for _, r := range order {
+		q := make([]string, 0, len(names[r]))
+		for _, n := range names[r] {
+			q = append(q, "`"+n+"`")
+		}
+		fmt.Printf("\t0x%04X: {%s},\n", r, strings.Join(q, ", "))
+	}
+	fmt.Print("}\n")
+}
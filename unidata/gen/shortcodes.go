@@ -0,0 +1,126 @@
+//go:build generate
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+func main() {
+	if len(os.Args) < 2 || len(os.Args) > 4 {
+		zli.Fatalf("usage: shortcodes.go gemoji.json [emoji-data.json [joypixels.json]]")
+	}
+	sources := []string{"gemoji"}
+
+	var (
+		codes = make(map[string][]string)
+		full  = make(map[string]string)
+		order []string
+	)
+	add := func(emoji string, names ...string) {
+		// Variation selectors aren't always used consistently, so match
+		// without them and keep the fully-qualified form with the most.
+		key := strings.ReplaceAll(emoji, "\ufe0f", "")
+		if key == "" {
+			return
+		}
+		if len(emoji) > len(full[key]) {
+			full[key] = emoji
+		}
+		for _, n := range names {
+			n = strings.Trim(n, ":")
+			if n == "" || slices.Contains(codes[key], n) {
+				continue
+			}
+			if _, ok := codes[key]; !ok {
+				order = append(order, key)
+			}
+			codes[key] = append(codes[key], n)
+		}
+	}
+	fromHex := func(hex string) string {
+		var b strings.Builder
+		for _, h := range strings.Split(hex, "-") {
+			r, err := strconv.ParseUint(h, 16, 32)
+			zli.F(err)
+			b.WriteRune(rune(r))
+		}
+		return b.String()
+	}
+
+	// GitHub's gemoji.
+	var gemoji []struct {
+		Emoji   string   `json:"emoji"`
+		Aliases []string `json:"aliases"`
+	}
+	readJSON(os.Args[1], &gemoji)
+	for _, e := range gemoji {
+		add(e.Emoji, e.Aliases...)
+	}
+
+	// emoji-data, as used by Slack.
+	if len(os.Args) > 2 {
+		var emojiData []struct {
+			Unified    string   `json:"unified"`
+			ShortNames []string `json:"short_names"`
+		}
+		readJSON(os.Args[2], &emojiData)
+		for _, e := range emojiData {
+			add(fromHex(e.Unified), e.ShortNames...)
+		}
+		sources = append(sources, "emoji-data")
+	}
+
+	// JoyPixels, as used by Discord. Skin tone variants are left out, as
+	// they're always the name with "_tone1" to "_tone5" appended.
+	if len(os.Args) > 3 {
+		var joypixels map[string]struct {
+			Shortname  string   `json:"shortname"`
+			Alternates []string `json:"shortname_alternates"`
+			Diversity  *string  `json:"diversity"`
+			CodePoints struct {
+				FullyQualified string `json:"fully_qualified"`
+			} `json:"code_points"`
+		}
+		readJSON(os.Args[3], &joypixels)
+		keys := make([]string, 0, len(joypixels))
+		for k := range joypixels {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			e := joypixels[k]
+			if e.Diversity != nil {
+				continue
+			}
+			add(fromHex(e.CodePoints.FullyQualified), append([]string{e.Shortname}, e.Alternates...)...)
+		}
+		sources = append(sources, "JoyPixels")
+	}
+
+	slices.Sort(order)
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Printf("// Shortcodes from %s, without colons.\n", strings.Join(sources, ", "))
+	fmt.Print("var emojiShortcodes = map[string][]string{\n")
+	for _, e := range order {
+		q := make([]string, 0, len(codes[e]))
+		for _, c := range codes[e] {
+			q = append(q, strconv.Quote(c))
+		}
+		fmt.Printf("\t%q: {%s},\n", full[e], strings.Join(q, ", "))
+	}
+	fmt.Print("}\n")
+}
+
+func readJSON(path string, v any) {
+	fp, err := os.ReadFile(path)
+	zli.F(err)
+	zli.F(json.Unmarshal(fp, v))
+}
@@ -0,0 +1,163 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+// Name of the <font> style → constant.
+var fontStyles = map[string]string{
+	"BOLD":                   "StyleBold",
+	"ITALIC":                 "StyleItalic",
+	"BOLD ITALIC":            "StyleBoldItalic",
+	"SCRIPT":                 "StyleScript",
+	"BOLD SCRIPT":            "StyleBoldScript",
+	"FRAKTUR":                "StyleFraktur",
+	"BLACK-LETTER":           "StyleFraktur",
+	"BOLD FRAKTUR":           "StyleBoldFraktur",
+	"DOUBLE-STRUCK":          "StyleDoubleStruck",
+	"SANS-SERIF":             "StyleSans",
+	"SANS-SERIF BOLD":        "StyleSansBold",
+	"SANS-SERIF ITALIC":      "StyleSansItalic",
+	"SANS-SERIF BOLD ITALIC": "StyleSansBoldItalic",
+	"MONOSPACE":              "StyleMonospace",
+}
+
+var (
+	reFont      = regexp.MustCompile(`^(?:MATHEMATICAL )?(.+?) (?:CAPITAL|SMALL|DIGIT|NABLA|PARTIAL|EPSILON|THETA|KAPPA|PHI|RHO|PI)\b`)
+	reSmallCaps = regexp.MustCompile(`^LATIN LETTER SMALL CAPITAL ([A-Z])$`)
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: styles.go [UnicodeData.txt]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	type styled struct {
+		cp    rune
+		name  string
+		prio  bool
+		plain rune
+	}
+	var (
+		styles  = make(map[string][]styled)
+		unstyle = make(map[rune]string)
+		order   []rune
+	)
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		f := strings.Split(scan.Text(), ";")
+		cp, err := strconv.ParseUint(f[0], 16, 32)
+		zli.F(err)
+		name := f[1]
+
+		if m := reSmallCaps.FindStringSubmatch(name); m != nil {
+			p := rune(strings.ToLower(m[1])[0])
+			styles["StyleSmallCaps"] = append(styles["StyleSmallCaps"], styled{rune(cp), name, true, p})
+			unstyle[rune(cp)] = string(p)
+			order = append(order, rune(cp))
+			continue
+		}
+
+		tag, dm, ok := strings.Cut(f[5], " ")
+		if !ok || tag[0] != '<' {
+			continue
+		}
+		var decomp []rune
+		for _, d := range strings.Fields(dm) {
+			r, err := strconv.ParseUint(d, 16, 32)
+			zli.F(err)
+			decomp = append(decomp, rune(r))
+		}
+
+		var style string
+		switch tag {
+		default:
+			continue
+		case "<circle>":
+			style = "StyleCircled"
+		case "<wide>":
+			style = "StyleFullwidth"
+		case "<super>":
+			style = "StyleSuperscript"
+		case "<sub>":
+			style = "StyleSubscript"
+		case "<font>":
+			/// PLANCK CONSTANT is the italic h.
+			if cp == 0x210e {
+				style = "StyleItalic"
+				break
+			}
+			m := reFont.FindStringSubmatch(name)
+			if m == nil {
+				continue
+			}
+			style = fontStyles[m[1]]
+		}
+		if style == "" {
+			continue
+		}
+
+		unstyle[rune(cp)] = string(decomp)
+		order = append(order, rune(cp))
+		if len(decomp) == 1 {
+			prio := strings.HasPrefix(name, "MATHEMATICAL ") || strings.HasPrefix(name, "CIRCLED ") ||
+				strings.HasPrefix(name, "FULLWIDTH ") || strings.HasPrefix(name, "SUPERSCRIPT ") ||
+				strings.HasPrefix(name, "SUBSCRIPT ") || strings.HasPrefix(name, "MODIFIER LETTER ")
+			styles[style] = append(styles[style], styled{rune(cp), name, prio, decomp[0]})
+		}
+	}
+	zli.F(scan.Err())
+
+	names := make([]string, 0, len(styles))
+	for k := range styles {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n")
+	fmt.Print("// Styled variants of characters, from the compatibility decompositions.\n" +
+		"var styleMap = map[Style]map[rune]rune{\n")
+	for _, n := range names {
+		/// Prefer e.g. MODIFIER LETTER SMALL A over FEMININE ORDINAL INDICATOR
+		/// if there's more than one; otherwise use the first.
+		m := make(map[rune]styled)
+		var plain []rune
+		for _, s := range styles[n] {
+			have, ok := m[s.plain]
+			if !ok {
+				plain = append(plain, s.plain)
+			}
+			if !ok || (s.prio && !have.prio) {
+				m[s.plain] = s
+			}
+		}
+		slices.Sort(plain)
+		fmt.Printf("\t%s: {\n", n)
+		for _, p := range plain {
+			fmt.Printf("\t\t0x%04X: 0x%04X, // %s\n", p, m[p].cp, m[p].name)
+		}
+		fmt.Print("\t},\n")
+	}
+	fmt.Print("}\n\n")
+
+	fmt.Print("// Plain text for styled characters.\n" +
+		"var unstyleMap = map[rune]string{\n")
+	for _, r := range order {
+		fmt.Printf("\t0x%04X: %q,\n", r, unstyle[r])
+	}
+	fmt.Print("}\n")
+}
@@ -0,0 +1,46 @@
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zgo.at/zli"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		zli.Fatalf("usage: translit.go [Unihan_Readings.txt]")
+	}
+
+	fp, err := os.Open(os.Args[1])
+	zli.F(err)
+	defer fp.Close()
+
+	fmt.Print("// Code generated by gen.zsh; DO NOT EDIT\n\npackage unidata\n\n" +
+		"// Most common Mandarin reading in pinyin, from kMandarin in Unihan.\n" +
+		"var mandarin = map[rune]string{\n")
+
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := scan.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Split(line, "\t")
+		if len(f) != 3 || f[1] != "kMandarin" {
+			continue
+		}
+		cp, err := strconv.ParseUint(strings.TrimPrefix(f[0], "U+"), 16, 32)
+		zli.F(err)
+
+		/// The first reading is the preferred one for zh-Hans.
+		fmt.Printf("\t0x%04X: %q,\n", cp, strings.Fields(f[2])[0])
+	}
+	zli.F(scan.Err())
+	fmt.Print("}\n")
+}
@@ -0,0 +1,254 @@
+package unidata
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	IDStatus         uint8    // Identifier status from UTS #39
+	IDType           uint8    // Identifier type from UTS #39
+	IDTypeList       []IDType // Identifier types from UTS #39
+	RestrictionLevel uint8    // Restriction level from UTS #39
+)
+
+func (s IDStatus) String() string         { return IDStatuses[s] }
+func (t IDType) String() string           { return IDTypes[t].Name }
+func (r RestrictionLevel) String() string { return RestrictionLevels[r] }
+func (t IDTypeList) String() string {
+	var b strings.Builder
+	for i, tt := range t {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(IDTypes[tt].Name)
+	}
+	return b.String()
+}
+
+// Identifier statuses.
+const (
+	IDStatusRestricted = IDStatus(iota)
+	IDStatusAllowed
+)
+
+// IDStatuses is a list of all identifier statuses.
+var IDStatuses = map[IDStatus]string{
+	IDStatusRestricted: "Restricted",
+	IDStatusAllowed:    "Allowed",
+}
+
+// Restriction levels, from least to most permissive.
+const (
+	RestrictionASCIIOnly = RestrictionLevel(iota)
+	RestrictionSingleScript
+	RestrictionHighlyRestrictive
+	RestrictionModeratelyRestrictive
+	RestrictionMinimallyRestrictive
+	RestrictionUnrestricted
+)
+
+// RestrictionLevels is a list of all restriction levels.
+var RestrictionLevels = map[RestrictionLevel]string{
+	RestrictionASCIIOnly:             "ASCII-Only",
+	RestrictionSingleScript:          "Single Script",
+	RestrictionHighlyRestrictive:     "Highly Restrictive",
+	RestrictionModeratelyRestrictive: "Moderately Restrictive",
+	RestrictionMinimallyRestrictive:  "Minimally Restrictive",
+	RestrictionUnrestricted:          "Unrestricted",
+}
+
+// FindRestrictionLevel finds a restriction level by name.
+func FindRestrictionLevel(name string) (RestrictionLevel, bool) {
+	var (
+		match = matchName(name)
+		found []RestrictionLevel
+	)
+	for k, r := range RestrictionLevels {
+		if matchName(r) == match {
+			return k, true
+		}
+		if strings.HasPrefix(matchName(r), match) {
+			found = append(found, k)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return 0, false
+	case 1:
+		return found[0], true
+	default:
+		return 0, false
+	}
+}
+
+// IDStatus gets the identifier status for this codepoint.
+func (c Codepoint) IDStatus() IDStatus {
+	for _, r := range idAllowed {
+		if c.Codepoint >= r[0] && c.Codepoint <= r[1] {
+			return IDStatusAllowed
+		}
+	}
+	return IDStatusRestricted
+}
+
+// IDType gets the identifier types for this codepoint.
+func (c Codepoint) IDType() IDTypeList {
+	all := make(IDTypeList, 0, 1)
+	for k, v := range IDTypes {
+		for _, r := range v.Ranges {
+			if c.Codepoint >= r[0] && c.Codepoint <= r[1] {
+				all = append(all, k)
+			}
+		}
+	}
+	slices.Sort(all)
+	return all
+}
+
+// ScriptExtensions gets all the scripts this codepoint is used in.
+//
+// For most codepoints this is identical to Script(), but some codepoints are
+// used in more than one script. For example U+30FC (KATAKANA-HIRAGANA
+// PROLONGED SOUND MARK) has the script Common, but is only used with Hiragana
+// and Katakana.
+func (c Codepoint) ScriptExtensions() []Script {
+	for _, e := range scriptExtensions {
+		if c.Codepoint >= e.rng[0] && c.Codepoint <= e.rng[1] {
+			return e.scripts
+		}
+	}
+	return []Script{c.Script()}
+}
+
+// Scripts recommended for use in identifiers; from UAX #31 table 5.
+var recommendedScripts = []Script{ScriptArabic, ScriptArmenian, ScriptBengali,
+	ScriptBopomofo, ScriptCyrillic, ScriptDevanagari, ScriptEthiopic,
+	ScriptGeorgian, ScriptGreek, ScriptGujarati, ScriptGurmukhi, ScriptHan,
+	ScriptHangul, ScriptHebrew, ScriptHiragana, ScriptKannada, ScriptKatakana,
+	ScriptKhmer, ScriptLao, ScriptLatin, ScriptMalayalam, ScriptMyanmar,
+	ScriptOriya, ScriptSinhala, ScriptTamil, ScriptTelugu, ScriptThaana,
+	ScriptThai, ScriptTibetan}
+
+// Han is augmented with these sets when resolving scripts, so that e.g. Han
+// and Hiragana resolve to a single script (Japanese).
+var augmentedScripts = [][]Script{
+	{ScriptHan, ScriptHiragana, ScriptKatakana}, // Jpan
+	{ScriptHan, ScriptHangul},                   // Kore
+	{ScriptHan, ScriptBopomofo},                 // Hanb
+}
+
+// Restriction gets the restriction level of the string s, as described in
+// UTS #39 section 5.2.
+//
+// The returned scripts are the scripts that led to this restriction level;
+// for "Single Script" this is the resolved script set, and for the other
+// levels the scripts the string is covered by.
+func Restriction(s string) (RestrictionLevel, []Script) {
+	var (
+		ascii = true
+		allow = true
+		sets  = make([][]Script, 0, 4)
+	)
+	for _, r := range s {
+		c, _ := Find(r)
+		if r > 0x7f {
+			ascii = false
+		}
+		if c.IDStatus() != IDStatusAllowed {
+			allow = false
+		}
+
+		// Common and Inherited are used in every script, so they never change
+		// the resolved script set.
+		scx := c.ScriptExtensions()
+		if len(scx) == 1 && (scx[0] == ScriptCommon || scx[0] == ScriptInherited) {
+			continue
+		}
+		if !slices.ContainsFunc(sets, func(s []Script) bool { return slices.Equal(s, scx) }) {
+			sets = append(sets, scx)
+		}
+	}
+
+	// Each set must intersect with the candidate.
+	coveredBy := func(candidate ...Script) bool {
+		for _, s := range sets {
+			if !slices.ContainsFunc(s, func(ss Script) bool { return slices.Contains(candidate, ss) }) {
+				return false
+			}
+		}
+		return true
+	}
+	union := func() []Script {
+		u := make([]Script, 0, len(sets))
+		for _, s := range sets {
+			for _, ss := range s {
+				if !slices.Contains(u, ss) {
+					u = append(u, ss)
+				}
+			}
+		}
+		return u
+	}
+
+	switch {
+	case !allow:
+		return RestrictionUnrestricted, union()
+	case ascii:
+		return RestrictionASCIIOnly, union()
+	case len(sets) == 0:
+		return RestrictionSingleScript, []Script{ScriptCommon}
+	}
+
+	resolved := slices.Clone(sets[0])
+	for _, s := range sets[1:] {
+		resolved = slices.DeleteFunc(resolved, func(ss Script) bool { return !slices.Contains(s, ss) })
+	}
+	if len(resolved) > 0 {
+		return RestrictionSingleScript, resolved
+	}
+	for _, a := range augmentedScripts {
+		if coveredBy(a...) {
+			return RestrictionSingleScript, a
+		}
+	}
+
+	for _, a := range augmentedScripts {
+		if c := append([]Script{ScriptLatin}, a...); coveredBy(c...) {
+			return RestrictionHighlyRestrictive, c
+		}
+	}
+
+	for _, sc := range recommendedScripts {
+		if sc == ScriptLatin || sc == ScriptCyrillic || sc == ScriptGreek {
+			continue
+		}
+		if coveredBy(ScriptLatin, sc) {
+			return RestrictionModeratelyRestrictive, []Script{ScriptLatin, sc}
+		}
+	}
+	return RestrictionMinimallyRestrictive, union()
+}
+
+// Skeleton gets the skeleton of s from UTS #39 section 4: every character is
+// replaced with its prototype from confusables.txt. Two strings with the same
+// skeleton are visually confusable, such as "paypal" and the Cyrillic
+// "раураӏ".
+func Skeleton(s string) string {
+	b := new(strings.Builder)
+	for _, r := range norm.NFD.String(s) {
+		if p, ok := confusables[r]; ok {
+			b.WriteString(p)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+// Confusable reports if a and b are visually confusable: they have the same
+// skeleton.
+func Confusable(a, b string) bool { return Skeleton(a) == Skeleton(b) }
@@ -0,0 +1,68 @@
+package unidata
+
+import xidna "golang.org/x/net/idna"
+
+// IDNAStatus is the IDNA status from UTS #46.
+type IDNAStatus uint8
+
+func (s IDNAStatus) String() string { return IDNAStatuses[s] }
+
+// IDNA statuses.
+const (
+	IDNADisallowed = IDNAStatus(iota)
+	IDNAValid
+	IDNAIgnored
+	IDNAMapped
+	IDNADeviation
+)
+
+// IDNAStatuses is a list of all IDNA statuses.
+var IDNAStatuses = map[IDNAStatus]string{
+	IDNADisallowed: "disallowed",
+	IDNAValid:      "valid",
+	IDNAIgnored:    "ignored",
+	IDNAMapped:     "mapped",
+	IDNADeviation:  "deviation",
+}
+
+// The same mapping as idna.Lookup, but without the label checks so that only
+// the status of the codepoint itself matters.
+var (
+	idnaLookup = xidna.New(xidna.MapForLookup(), xidna.Transitional(false),
+		xidna.CheckHyphens(false), xidna.CheckJoiners(false))
+	idnaTransitional = xidna.New(xidna.MapForLookup(), xidna.Transitional(true),
+		xidna.CheckHyphens(false), xidna.CheckJoiners(false))
+)
+
+// IDNA gets the IDNA status for this codepoint.
+//
+// This uses the lookup mapping from golang.org/x/net/idna (UseSTD3ASCIIRules
+// set, nontransitional), so it's always the same as the conversion done with
+// idna.Lookup; see idna.UnicodeVersion for the Unicode version.
+//
+// The mapping is the string this codepoint gets replaced with for the mapped
+// and deviation statuses. It's empty for all other statuses, and may also be
+// empty for deviations that are removed (e.g. U+200D ZERO WIDTH JOINER).
+func (c Codepoint) IDNA() (status IDNAStatus, mapping string) {
+	s := string(c.Codepoint)
+	m, err := idnaLookup.ToUnicode(s)
+	switch {
+	case err != nil:
+		return IDNADisallowed, ""
+	case m == "":
+		return IDNAIgnored, ""
+	case m != s:
+		return IDNAMapped, m
+	}
+
+	// ToUnicode is always nontransitional, so get the transitional mapping
+	// from ToASCII and convert that back.
+	t, err := idnaTransitional.ToASCII(s)
+	if err == nil {
+		t, err = xidna.Punycode.ToUnicode(t)
+	}
+	if err == nil && t != s {
+		return IDNADeviation, t
+	}
+	return IDNAValid, ""
+}
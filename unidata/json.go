@@ -0,0 +1,142 @@
+package unidata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler; all enum types are written as
+// their name, so they're also written as a string in JSON.
+func (w Width) MarshalText() ([]byte, error)            { return []byte(w.String()), nil }
+func (c Category) MarshalText() ([]byte, error)         { return []byte(c.String()), nil }
+func (p Plane) MarshalText() ([]byte, error)            { return []byte(p.String()), nil }
+func (b Block) MarshalText() ([]byte, error)            { return []byte(b.String()), nil }
+func (s Script) MarshalText() ([]byte, error)           { return []byte(s.String()), nil }
+func (p Property) MarshalText() ([]byte, error)         { return []byte(p.String()), nil }
+func (u Unicode) MarshalText() ([]byte, error)          { return []byte(u.String()), nil }
+func (s IDStatus) MarshalText() ([]byte, error)         { return []byte(s.String()), nil }
+func (t IDType) MarshalText() ([]byte, error)           { return []byte(t.String()), nil }
+func (r RestrictionLevel) MarshalText() ([]byte, error) { return []byte(r.String()), nil }
+func (s IDNAStatus) MarshalText() ([]byte, error)       { return []byte(s.String()), nil }
+func (e EmojiGroup) MarshalText() ([]byte, error)       { return []byte(e.String()), nil }
+func (e EmojiSubgroup) MarshalText() ([]byte, error)    { return []byte(e.String()), nil }
+func (s Style) MarshalText() ([]byte, error)            { return []byte(s.String()), nil }
+func (s TranslitScheme) MarshalText() ([]byte, error)   { return []byte(s.String()), nil }
+func (m WidthModel) MarshalText() ([]byte, error)       { return []byte(m.String()), nil }
+
+// MarshalText implements encoding.TextMarshaler; the codepoint is written as
+// U+XXXX, which can be read back with FromString().
+func (c Codepoint) MarshalText() ([]byte, error) { return []byte(c.FormatCodepoint()), nil }
+
+// MarshalJSON implements json.Marshaler; the codepoint is written as an object
+// with all properties, using the same keys as "uni -json-typed".
+func (c Codepoint) MarshalJSON() ([]byte, error) {
+	idna, mapping := c.IDNA()
+	return json.Marshal(struct {
+		Aliases     []string     `json:"aliases"`
+		ASCII       string       `json:"ascii"`
+		Bin         string       `json:"bin"`
+		Block       Block        `json:"block"`
+		Cat         Category     `json:"cat"`
+		Cells       int          `json:"cells"`
+		Char        string       `json:"char"`
+		Compose     []string     `json:"compose"`
+		CPoint      string       `json:"cpoint"`
+		Dec         int          `json:"dec"`
+		Digraph     string       `json:"digraph"`
+		Hex         string       `json:"hex"`
+		HTML        string       `json:"html"`
+		IDStatus    IDStatus     `json:"id_status"`
+		IDType      IDTypeList   `json:"id_type"`
+		IDNA        IDNAStatus   `json:"idna"`
+		IDNAMapping string       `json:"idna_mapping"`
+		JSON        string       `json:"json"`
+		KeySym      string       `json:"keysym"`
+		LaTeX       []string     `json:"latex"`
+		Name        string       `json:"name"`
+		Oct         string       `json:"oct"`
+		Plane       Plane        `json:"plane"`
+		Props       PropertyList `json:"props"`
+		Refs        []string     `json:"refs"`
+		Script      Script       `json:"script"`
+		Unicode     Unicode      `json:"unicode"`
+		UTF16BE     string       `json:"utf16be"`
+		UTF16LE     string       `json:"utf16le"`
+		UTF8        string       `json:"utf8"`
+		Width       Width        `json:"width"`
+		XML         string       `json:"xml"`
+	}{
+		Aliases:     nonNil(c.Aliases()),
+		ASCII:       c.ASCII(),
+		Bin:         c.Format(2),
+		Block:       c.Block(),
+		Cat:         c.Category(),
+		Cells:       c.CellsWith(WidthModelUnicode),
+		Char:        string(c.Codepoint),
+		Compose:     c.composeFormatted(),
+		CPoint:      c.FormatCodepoint(),
+		Dec:         int(c.Codepoint),
+		Digraph:     c.Digraph(),
+		Hex:         c.Format(16),
+		HTML:        c.HTML(),
+		IDStatus:    c.IDStatus(),
+		IDType:      nonNil(c.IDType()),
+		IDNA:        idna,
+		IDNAMapping: mapping,
+		JSON:        c.JSON(),
+		KeySym:      c.KeySym(),
+		LaTeX:       nonNil(c.LaTeX()),
+		Name:        c.Name(),
+		Oct:         c.Format(8),
+		Plane:       c.Plane(),
+		Props:       nonNil(c.Properties()),
+		Refs:        nonNil(c.Refs()),
+		Script:      c.Script(),
+		Unicode:     c.Unicode(),
+		UTF16BE:     fmt.Sprintf("% x", c.UTF16(true)),
+		UTF16LE:     fmt.Sprintf("% x", c.UTF16(false)),
+		UTF8:        fmt.Sprintf("% x", c.UTF8()),
+		Width:       c.Width(),
+		XML:         c.XML(),
+	})
+}
+
+// MarshalText implements encoding.TextMarshaler; the emoji is written as-is.
+func (e Emoji) MarshalText() ([]byte, error) { return []byte(e.String()), nil }
+
+// MarshalJSON implements json.Marshaler; the emoji is written as an object,
+// using the same keys as "uni emoji -json-typed".
+func (e Emoji) MarshalJSON() ([]byte, error) {
+	s := e.String()
+	cp := make([]string, 0, len(e.Codepoints))
+	for _, c := range s {
+		cp = append(cp, fmt.Sprintf("U+%04X", c))
+	}
+	return json.Marshal(struct {
+		CLDR      []string      `json:"cldr"`
+		CLDRFull  []string      `json:"cldr_full"`
+		CPoint    []string      `json:"cpoint"`
+		Emoji     string        `json:"emoji"`
+		Group     EmojiGroup    `json:"group"`
+		Name      string        `json:"name"`
+		Shortcode []string      `json:"shortcode"`
+		Subgroup  EmojiSubgroup `json:"subgroup"`
+	}{
+		CLDR:      nonNil(e.Keywords()),
+		CLDRFull:  nonNil(e.CLDR),
+		CPoint:    cp,
+		Emoji:     s,
+		Group:     e.Group(),
+		Name:      e.Name,
+		Shortcode: nonNil(e.Shortcodes()),
+		Subgroup:  e.Subgroup(),
+	})
+}
+
+// Make sure slices are written as [] rather than null.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
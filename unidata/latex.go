@@ -0,0 +1,35 @@
+package unidata
+
+import "sync"
+
+var (
+	latexByName     map[string]rune
+	latexByNameOnce sync.Once
+)
+
+// LaTeX gets the LaTeX and unicode-math names for this codepoint, such as
+// `\rightarrow` and `\to` for →, or `\mathbb{R}` and `\BbbR` for ℝ.
+//
+// The first name is the LaTeX name if there is one, followed by the
+// unicode-math name and any aliases.
+func (c Codepoint) LaTeX() []string { return latexNames[c.Codepoint] }
+
+// FindLaTeX finds the character for a LaTeX or unicode-math name, such as → for
+// `\rightarrow` or ℝ for `\mathbb{R}`. The leading backslash is optional.
+//
+// Names are case-sensitive: `\Delta` is Δ and `\delta` is δ.
+func FindLaTeX(name string) (rune, bool) {
+	latexByNameOnce.Do(func() {
+		latexByName = make(map[string]rune)
+		for r, names := range latexNames {
+			for _, n := range names {
+				latexByName[n] = r
+			}
+		}
+	})
+	if len(name) > 0 && name[0] != '\\' {
+		name = `\` + name
+	}
+	r, ok := latexByName[name]
+	return r, ok
+}
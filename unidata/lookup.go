@@ -0,0 +1,74 @@
+package unidata
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	htmlByName     map[string]string
+	htmlByNameOnce sync.Once
+
+	keysymByName     map[string]rune
+	keysymByNameOnce sync.Once
+
+	digraphBySeq     map[string]rune
+	digraphBySeqOnce sync.Once
+)
+
+// HTMLEntities gets all HTML entity names for this codepoint, without & and ;
+// the first is the one used for HTML().
+func (c Codepoint) HTMLEntities() []string { return htmlEntities[string(c.Codepoint)] }
+
+// FindHTML finds the text for an HTML entity name, such as "→" for "&rarr;".
+// The & and ; are optional.
+//
+// Some entities are for more than one codepoint: "&NotEqualTilde;" is "≂̸"
+// (U+2242 U+0338). Names are case-sensitive.
+func FindHTML(name string) (string, bool) {
+	htmlByNameOnce.Do(func() {
+		htmlByName = make(map[string]string)
+		for text, names := range htmlEntities {
+			for _, n := range names {
+				htmlByName[n] = text
+			}
+		}
+	})
+	text, ok := htmlByName[strings.TrimSuffix(strings.TrimPrefix(name, "&"), ";")]
+	return text, ok
+}
+
+// FindKeySym finds the codepoint for an X11 keysym name, such as € for
+// "EuroSign".
+func FindKeySym(name string) (rune, bool) {
+	keysymByNameOnce.Do(func() {
+		keysymByName = make(map[string]rune, len(keysyms))
+		for r, k := range keysyms {
+			keysymByName[k] = r
+		}
+	})
+	r, ok := keysymByName[name]
+	return r, ok
+}
+
+// Digraphs that Digraph() doesn't return, as there's only one per codepoint.
+var digraphAliases = map[string]rune{
+	"Eu": 0x20ac, // € in RFC 1345; Digraph() returns Vim's "=e".
+	"=P": 0x20bd, // ₽ in Vim, as well as "=R".
+}
+
+// FindDigraph finds the codepoint for an RFC 1345 digraph, such as € for "Eu".
+// This also accepts the Vim digraphs listed for Digraph(), and "=P" for ₽.
+func FindDigraph(seq string) (rune, bool) {
+	digraphBySeqOnce.Do(func() {
+		digraphBySeq = make(map[string]rune, len(digraphs)+len(digraphAliases))
+		for r, d := range digraphs {
+			digraphBySeq[d] = r
+		}
+		for d, r := range digraphAliases {
+			digraphBySeq[d] = r
+		}
+	})
+	r, ok := digraphBySeq[seq]
+	return r, ok
+}
@@ -0,0 +1,280 @@
+package unidata
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var (
+	shortcodeOnce    sync.Once
+	shortcodeByEmoji map[string][]string // Emoji without modifiers → shortcodes.
+	shortcodeToEmoji map[string]string   // Shortcode → emoji.
+	shortcodeMaxLen  int                 // Longest emoji, in runes.
+)
+
+// Skin tone modifiers; as a suffix :skin-tone-2: is light and :skin-tone-6:
+// dark, and with _tone1 to _tone5 it's _tone1 for light.
+var skinTones = []rune{0x1f3fb, 0x1f3fc, 0x1f3fd, 0x1f3fe, 0x1f3ff}
+
+func loadShortcodes() {
+	shortcodeOnce.Do(func() {
+		shortcodeByEmoji = make(map[string][]string, len(emojiShortcodes)+len(Emojis))
+		shortcodeToEmoji = make(map[string]string, len(emojiShortcodes)*2+len(Emojis))
+		add := func(emoji string, codes ...string) {
+			key, _, _ := stripModifiers(emoji)
+			for _, c := range codes {
+				if _, ok := shortcodeToEmoji[c]; ok {
+					continue
+				}
+				shortcodeToEmoji[c] = emoji
+				shortcodeByEmoji[key] = append(shortcodeByEmoji[key], c)
+			}
+			if n := len([]rune(key)); n > shortcodeMaxLen {
+				shortcodeMaxLen = n
+			}
+		}
+		for e, codes := range emojiShortcodes {
+			add(e, codes...)
+		}
+		for _, e := range Emojis {
+			add(e.String(), cldrShortcode(e.Name))
+		}
+	})
+}
+
+// cldrShortcode gets the shortcode derived from the CLDR name: "thumbs up"
+// becomes "thumbs_up", and "flag: United States" becomes "flag_united_states".
+func cldrShortcode(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	under := false
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if under && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			under = false
+		} else {
+			under = true
+		}
+	}
+	return b.String()
+}
+
+// stripModifiers removes variation selectors and skin tone modifiers from an
+// emoji, returning the skin tone (0 if there is none). It reports false if
+// there's more than one skin tone that are not identical, as in a couple with
+// two different skin tones.
+func stripModifiers(emoji string) (string, rune, bool) {
+	var (
+		b    strings.Builder
+		tone rune
+		ok   = true
+	)
+	b.Grow(len(emoji))
+	for _, r := range emoji {
+		switch {
+		case r == 0xfe0f:
+		case r >= 0x1f3fb && r <= 0x1f3ff:
+			if tone != 0 && tone != r {
+				ok = false
+			}
+			tone = r
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), tone, ok
+}
+
+// withTone adds the skin tone modifier after the first codepoint of an emoji,
+// replacing a variation selector if there is one.
+func withTone(emoji string, tone rune) string {
+	if tone == 0 {
+		return emoji
+	}
+	r := []rune(emoji)
+	rest := r[1:]
+	if len(rest) > 0 && rest[0] == 0xfe0f {
+		rest = rest[1:]
+	}
+	return string(r[0]) + string(tone) + string(rest)
+}
+
+// Shortcodes gets the shortcodes for this emoji, such as ":+1:" and
+// ":thumbsup:" for 👍.
+//
+// This includes the shortcodes from gemoji, and the shortcode derived from the
+// CLDR name (":thumbs_up:"). For emojis with a skin tone the tone is added as a
+// suffix: ":+1::skin-tone-2:". Emojis with two different skin tones have no
+// shortcodes.
+func (e Emoji) Shortcodes() []string {
+	loadShortcodes()
+	key, tone, ok := stripModifiers(e.String())
+	var suffix string
+	if tone != 0 {
+		if !ok {
+			return nil
+		}
+		suffix = ":skin-tone-" + strconv.Itoa(int(tone-skinTones[0])+2) + ":"
+	}
+
+	codes := shortcodeByEmoji[key]
+	l := make([]string, 0, len(codes))
+	for _, c := range codes {
+		l = append(l, ":"+c+":"+suffix)
+	}
+	return l
+}
+
+// FindShortcode finds the emoji for a shortcode, such as 👍 for ":thumbsup:";
+// the colons are optional.
+//
+// A skin tone can be added as ":thumbsup::skin-tone-3:" (2 to 6) or
+// ":thumbsup_tone2:" (1 to 5).
+func FindShortcode(code string) (string, bool) {
+	loadShortcodes()
+
+	code = strings.TrimSuffix(strings.TrimPrefix(code, ":"), ":")
+	var tone rune
+	if i := strings.Index(code, "::skin-tone-"); i > -1 {
+		n, err := strconv.Atoi(code[i+12:])
+		if err != nil || n < 2 || n > 6 {
+			return "", false
+		}
+		code, tone = code[:i], skinTones[n-2]
+	} else if i := strings.LastIndex(code, "_tone"); i > -1 && len(code) == i+6 {
+		if _, ok := shortcodeToEmoji[code]; !ok {
+			n := int(code[i+5] - '0')
+			if n < 1 || n > 5 {
+				return "", false
+			}
+			code, tone = code[:i], skinTones[n-1]
+		}
+	}
+
+	e, ok := shortcodeToEmoji[code]
+	if !ok {
+		e, ok = shortcodeToEmoji[strings.ToLower(code)]
+	}
+	if !ok {
+		return "", false
+	}
+	return withTone(e, tone), true
+}
+
+// Emojify replaces all shortcodes in text with the emoji, for example
+// ":tada:" with 🎉. Unknown shortcodes are kept as-is.
+func Emojify(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for {
+		start := strings.IndexByte(text, ':')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(text[start+1:], ':')
+		if end == -1 {
+			break
+		}
+		end += start + 2
+
+		code := text[start:end]
+		if strings.HasPrefix(text[end:], ":skin-tone-") && len(text) >= end+13 && text[end+12] == ':' {
+			code = text[start : end+13]
+		}
+		e, ok := FindShortcode(code)
+		if !ok && len(code) > end-start {
+			code = text[start:end]
+			e, ok = FindShortcode(code)
+		}
+		if !ok {
+			// Not a shortcode; the closing colon may be the start of the
+			// next one.
+			b.WriteString(text[:end-1])
+			text = text[end-1:]
+			continue
+		}
+		b.WriteString(text[:start])
+		b.WriteString(e)
+		text = text[start+len(code):]
+	}
+	b.WriteString(text)
+	return b.String()
+}
+
+// Demojify replaces all emojis in text with the first shortcode, for example
+// 🎉 with ":tada:". Skin tones are written as a suffix, as in
+// ":+1::skin-tone-2:".
+//
+// ZWJ sequences without a shortcode, such as a couple with two different skin
+// tones, are kept as-is rather than replacing every part.
+func Demojify(text string) string {
+	loadShortcodes()
+
+	var (
+		b = new(strings.Builder)
+		r = []rune(text)
+	)
+	b.Grow(len(text))
+outer:
+	for i := 0; i < len(r); i++ {
+		// Find the longest sequence that's an emoji, ignoring modifiers.
+		end := zwjEnd(r, i)
+		for j := min(len(r), i+shortcodeMaxLen*3); j > i; j-- {
+			key, tone, ok := stripModifiers(string(r[i:j]))
+			if !ok || key == "" {
+				continue
+			}
+			codes, has := shortcodeByEmoji[key]
+			if !has {
+				continue
+			}
+			// Characters such as © are only emojis with a variation selector.
+			if j == i+1 && (j == len(r) || r[j] != 0xfe0f) && strings.ContainsRune(shortcodeToEmoji[codes[0]], 0xfe0f) {
+				continue
+			}
+			for j < len(r) && (r[j] == 0xfe0f || (tone != 0 && r[j] == tone)) {
+				j++
+			}
+			// Part of a longer ZWJ sequence.
+			if j < end {
+				break
+			}
+			b.WriteString(":" + codes[0] + ":")
+			if tone != 0 {
+				b.WriteString(":skin-tone-" + strconv.Itoa(int(tone-skinTones[0])+2) + ":")
+			}
+			i = j - 1
+			continue outer
+		}
+		if end > i+1 {
+			b.WriteString(string(r[i:end]))
+			i = end - 1
+			continue
+		}
+		b.WriteRune(r[i])
+	}
+	return b.String()
+}
+
+// zwjEnd gets the end of the ZWJ sequence that starts at r[i], or i+1 if
+// there is no ZWJ sequence.
+func zwjEnd(r []rune, i int) int {
+	j, zwj := i+1, false
+	for {
+		for j < len(r) && (r[j] == 0xfe0f || (r[j] >= 0x1f3fb && r[j] <= 0x1f3ff)) {
+			j++
+		}
+		if j+1 >= len(r) || r[j] != 0x200d {
+			if !zwj {
+				return i + 1
+			}
+			return j
+		}
+		j, zwj = j+2, true
+	}
+}
@@ -0,0 +1,105 @@
+package unidata
+
+import "testing"
+
+func TestFindShortcode(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{":tada:", "🎉", true},
+		{"tada", "🎉", true},
+		{":TADA:", "🎉", true},
+		{":party_popper:", "🎉", true},
+		{":thumbsup::skin-tone-2:", "👍🏻", true},
+		{":thumbsup::skin-tone-6:", "👍🏿", true},
+		{":thumbsup_tone1:", "👍🏻", true},
+		{":thumbsup_tone5:", "👍🏿", true},
+		{":woman_facepalming::skin-tone-3:", "🤦🏼‍♀️", true},
+		{":copyright:", "©️", true},
+
+		{":thumbsup::skin-tone-1:", "", false},
+		{":thumbsup::skin-tone-7:", "", false},
+		{":thumbsup::skin-tone-x:", "", false},
+		{":thumbsup_tone0:", "", false},
+		{":thumbsup_tone6:", "", false},
+		{":10:", "", false},
+		{":nope:", "", false},
+		{"::", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			have, ok := FindShortcode(tt.in)
+			if have != tt.want || ok != tt.wantOK {
+				t.Errorf("\nhave: %q %t\nwant: %q %t", have, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestEmojify(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"no shortcodes", "no shortcodes"},
+		{":tada:", "🎉"},
+		{"a:tada:b", "a🎉b"},
+		{":tada::tada:", "🎉🎉"},
+		{":10:30:", ":10:30:"},
+		{"at 10:30: :100:", "at 10:30: 💯"},
+		{"10:30:tada:", "10:30🎉"},
+		{":nope: :tada:", ":nope: 🎉"},
+		{":tada", ":tada"},
+		{":+1::skin-tone-3: :+1_tone2:", "👍🏼 👍🏼"},
+		{":+1::skin-tone-9:", "👍:skin-tone-9:"},
+		{":copyright: ©", "©️ ©"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if have := Emojify(tt.in); have != tt.want {
+				t.Errorf("\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestDemojify(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"no emojis", "no emojis"},
+		{"🎉", ":tada:"},
+		{"a🎉b🎉", "a:tada:b:tada:"},
+		{"👍🏼", ":+1::skin-tone-3:"},
+		{"🤦🏻‍♀️", ":woman_facepalming::skin-tone-2:"},
+		{"👨‍👩‍👧", ":family_man_woman_girl:"},
+		{"🇳🇱", ":netherlands:"},
+		{"#️⃣", ":hash:"},
+
+		// © and ® are only emojis with a variation selector.
+		{"©", "©"},
+		{"©️", ":copyright:"},
+		{"© ©️", "© :copyright:"},
+
+		// No shortcode for two different skin tones, or a ZWJ sequence that's
+		// not an emoji: keep the entire sequence rather than every part.
+		{"👩🏻‍🤝‍👩🏿", "👩🏻‍🤝‍👩🏿"},
+		{"x 👩🏻‍🤝‍👩🏿 🎉", "x 👩🏻‍🤝‍👩🏿 :tada:"},
+		{"🧑🏻‍❤️‍💋‍🧑🏿", "🧑🏻‍❤️‍💋‍🧑🏿"},
+		{"🎉‍🎉", "🎉‍🎉"},
+		{"🎉‍", ":tada:‍"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if have := Demojify(tt.in); have != tt.want {
+				t.Errorf("\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
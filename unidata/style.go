@@ -0,0 +1,143 @@
+package unidata
+
+import (
+	"slices"
+	"strings"
+)
+
+// Style is a text style, such as bold or circled.
+type Style uint8
+
+func (s Style) String() string { return Styles[s] }
+
+// Text styles.
+const (
+	StyleBold = Style(iota)
+	StyleItalic
+	StyleBoldItalic
+	StyleScript
+	StyleBoldScript
+	StyleFraktur
+	StyleBoldFraktur
+	StyleDoubleStruck
+	StyleSans
+	StyleSansBold
+	StyleSansItalic
+	StyleSansBoldItalic
+	StyleMonospace
+	StyleCircled
+	StyleFullwidth
+	StyleSmallCaps
+	StyleSuperscript
+	StyleSubscript
+	StyleUpsideDown
+)
+
+// Styles is a list of all text styles.
+var Styles = map[Style]string{
+	StyleBold:           "bold",
+	StyleItalic:         "italic",
+	StyleBoldItalic:     "bold-italic",
+	StyleScript:         "script",
+	StyleBoldScript:     "bold-script",
+	StyleFraktur:        "fraktur",
+	StyleBoldFraktur:    "bold-fraktur",
+	StyleDoubleStruck:   "double-struck",
+	StyleSans:           "sans",
+	StyleSansBold:       "sans-bold",
+	StyleSansItalic:     "sans-italic",
+	StyleSansBoldItalic: "sans-bold-italic",
+	StyleMonospace:      "monospace",
+	StyleCircled:        "circled",
+	StyleFullwidth:      "fullwidth",
+	StyleSmallCaps:      "smallcaps",
+	StyleSuperscript:    "superscript",
+	StyleSubscript:      "subscript",
+	StyleUpsideDown:     "upside-down",
+}
+
+// FindStyle finds a text style by name.
+func FindStyle(name string) (Style, bool) {
+	var (
+		match = matchName(name)
+		found []Style
+	)
+	for k, s := range Styles {
+		if matchName(s) == match {
+			return k, true
+		}
+		if strings.HasPrefix(matchName(s), match) {
+			found = append(found, k)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return 0, false
+	case 1:
+		return found[0], true
+	default:
+		return 0, false
+	}
+}
+
+// Stylize converts the text in s to the given style.
+//
+// Most styles are done with the compatibility decompositions from the
+// Mathematical Alphanumeric Symbols, Letterlike Symbols, Enclosed
+// Alphanumerics, Halfwidth and Fullwidth Forms, and superscript and subscript
+// characters. Small caps only changes lower-case letters, and upside-down
+// also reverses the text.
+//
+// Characters without a styled variant are kept as-is; not all styles have
+// variants for all letters and digits (e.g. there is no superscript "q").
+func Stylize(s string, style Style) string {
+	m := styleMap[style]
+	if style == StyleUpsideDown {
+		m = upsideDown
+	}
+
+	styled := []rune(s)
+	for i, r := range styled {
+		if sr, ok := m[r]; ok {
+			styled[i] = sr
+		}
+	}
+	if style == StyleUpsideDown {
+		slices.Reverse(styled)
+	}
+	return string(styled)
+}
+
+// Unstyle converts styled text back to plain text; e.g. "𝓯𝓪𝓷𝓬𝔂" to
+// "fancy".
+//
+// Upside-down text isn't converted, as there is no way to tell it apart from
+// regular text (e.g. "ɐ" is also used in the IPA).
+func Unstyle(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if p, ok := unstyleMap[r]; ok {
+			b.WriteString(p)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Upside-down variants; these are not really upside-down versions, but
+// characters that look like it.
+var upsideDown = map[rune]rune{
+	'a': 'ɐ', 'b': 'q', 'c': 'ɔ', 'd': 'p', 'e': 'ǝ', 'f': 'ɟ', 'g': 'ƃ', 'h': 'ɥ',
+	'i': 'ᴉ', 'j': 'ɾ', 'k': 'ʞ', 'm': 'ɯ', 'n': 'u', 'p': 'd', 'q': 'b', 'r': 'ɹ',
+	't': 'ʇ', 'u': 'n', 'v': 'ʌ', 'w': 'ʍ', 'y': 'ʎ',
+	'A': '∀', 'B': 'ꓭ', 'C': 'Ɔ', 'D': 'ᗡ', 'E': 'Ǝ', 'F': 'Ⅎ', 'G': '⅁', 'J': 'ſ',
+	'K': 'ꓘ', 'L': '˥', 'M': 'W', 'P': 'Ԁ', 'Q': 'Ό', 'R': 'ꓤ', 'T': '⊥', 'U': '∩',
+	'V': 'Λ', 'W': 'M', 'Y': '⅄',
+	'1': 'Ɩ', '2': '↊', '3': '↋', '4': 'ㄣ', '5': 'ϛ', '6': '9', '7': 'ㄥ', '9': '6',
+	'.': '˙', ',': '\'', '\'': ',', '"': '„', '?': '¿', '!': '¡', '(': ')', ')': '(',
+	'[': ']', ']': '[', '{': '}', '}': '{', '<': '>', '>': '<', '_': '‾', '&': '⅋',
+	';': '؛', '‿': '⁀',
+}
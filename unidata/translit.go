@@ -0,0 +1,445 @@
+package unidata
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// TranslitScheme is a transliteration scheme.
+type TranslitScheme uint8
+
+func (s TranslitScheme) String() string { return TranslitSchemes[s] }
+
+// Transliteration schemes.
+const (
+	// ASCII approximation of the text.
+	TranslitASCII = TranslitScheme(iota)
+
+	// Like TranslitASCII, but lower-case and with everything except letters
+	// and digits replaced with "-"; for URLs and filenames.
+	TranslitSlug
+
+	// ISO 9:1995 for Cyrillic; this doesn't produce ASCII. Other text is
+	// unchanged.
+	TranslitISO9
+
+	// Pinyin with tone marks for Han characters; this doesn't produce ASCII.
+	// Other text is unchanged.
+	TranslitPinyin
+)
+
+// TranslitSchemes is a list of all transliteration schemes.
+var TranslitSchemes = map[TranslitScheme]string{
+	TranslitASCII:  "ascii",
+	TranslitSlug:   "slug",
+	TranslitISO9:   "iso9",
+	TranslitPinyin: "pinyin",
+}
+
+// TranslitPart is a part of a transliterated string.
+//
+// This is usually one codepoint, but can be more if the transliteration
+// depends on more than one codepoint, such as "きゃ" (kya) or a base character
+// followed by combining characters.
+type TranslitPart struct {
+	In  string // Input text.
+	Out string // Transliterated text; may be empty if it was removed.
+}
+
+// ASCII gets an ASCII approximation of this codepoint; this may be empty if
+// there is no good approximation.
+func (c Codepoint) ASCII() string {
+	t, _ := Transliterate(string(c.Codepoint), TranslitASCII)
+	return t
+}
+
+// Transliterate the string s with the given scheme.
+//
+// For TranslitASCII and TranslitSlug the text is decomposed (NFKD) with all
+// combining marks removed, and Cyrillic, Greek, Arabic, Hebrew, Hangul, kana,
+// and Han are converted with per-script tables. Anything that can't be
+// converted is removed.
+//
+// The returned parts list what every part of the input was converted to.
+func Transliterate(s string, scheme TranslitScheme) (string, []TranslitPart) {
+	var (
+		in     = []rune(s)
+		parts  = make([]TranslitPart, 0, len(in))
+		sokuon string // Pending small tsu (っ) to double the next consonant.
+	)
+	for i := 0; i < len(in); i++ {
+		r := in[i]
+
+		// Combining characters are part of the previous character.
+		if len(parts) > 0 && unicode.Is(unicode.Mn, r) {
+			p := &parts[len(parts)-1]
+			p.In += string(r)
+			if scheme == TranslitISO9 || scheme == TranslitPinyin {
+				p.Out += string(r)
+			}
+			continue
+		}
+
+		var out string
+		switch scheme {
+		case TranslitISO9:
+			out = translitISO9(r)
+		case TranslitPinyin:
+			out = string(r)
+			if p, ok := mandarin[r]; ok {
+				out = p
+			}
+		default:
+			k, ok := translitKana(r)
+			switch {
+			case !ok:
+				out = translitASCII(r)
+				if unicode.IsUpper(r) && out != "" {
+					if len(out) == 1 || i+1 < len(in) && unicode.IsUpper(in[i+1]) {
+						out = strings.ToUpper(out)
+					} else {
+						out = strings.ToUpper(out[:1]) + out[1:]
+					}
+				}
+			case r == 'っ' || r == 'ッ':
+				sokuon += string(r)
+				continue
+			case sokuon != "":
+				if k != "" && !strings.ContainsRune("aiueon-", rune(k[0])) {
+					c := k[:1]
+					if c == "c" {
+						c = "t"
+					}
+					k = c + k
+				}
+				parts = append(parts, TranslitPart{In: sokuon + string(r), Out: k})
+				sokuon = ""
+				continue
+			case translitKanaCombine(parts, r, k):
+				continue
+			default:
+				out = k
+			}
+		}
+		parts = append(parts, TranslitPart{In: string(r), Out: out})
+	}
+	if sokuon != "" {
+		parts = append(parts, TranslitPart{In: sokuon})
+	}
+
+	// Separate Han readings from each other and from surrounding letters:
+	// "北京abc" → "bei jing abc".
+	var (
+		b     strings.Builder
+		isHan = func(p TranslitPart) bool {
+			r, _ := utf8.DecodeRuneInString(p.In)
+			_, ok := mandarin[r]
+			return ok && p.In != p.Out
+		}
+	)
+	for i, p := range parts {
+		if i > 0 && p.Out != "" && (isHan(p) || isHan(parts[i-1])) {
+			last, _ := utf8.DecodeLastRuneInString(b.String())
+			first, _ := utf8.DecodeRuneInString(p.Out)
+			if (unicode.IsLetter(last) || unicode.IsDigit(last)) && (unicode.IsLetter(first) || unicode.IsDigit(first)) {
+				parts[i].Out = " " + p.Out
+			}
+		}
+		b.WriteString(parts[i].Out)
+	}
+
+	if scheme != TranslitSlug {
+		return b.String(), parts
+	}
+	for i := range parts {
+		parts[i].Out = strings.ToLower(parts[i].Out)
+	}
+	return slug(b.String()), parts
+}
+
+func isLetterOrDigit(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// slug lower-cases s and replaces every sequence of non-alphanumeric
+// characters with a single "-".
+func slug(s string) string {
+	var (
+		b    = make([]byte, 0, len(s))
+		dash = false
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if isLetterOrDigit(c) {
+			if dash && len(b) > 0 {
+				b = append(b, '-')
+			}
+			b = append(b, c)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return string(b)
+}
+
+// translitASCII converts a single codepoint to ASCII.
+func translitASCII(r rune) string {
+	if r < utf8.RuneSelf {
+		return string(r)
+	}
+	if p, ok := mandarin[r]; ok {
+		return stripMarks(p)
+	}
+	if t, ok := translitHangul(r); ok {
+		return t
+	}
+
+	lower := unicode.ToLower(r)
+	for _, tbl := range []map[rune]string{translitLatin, translitCyrillic, translitGreek,
+		translitArabic, translitHebrew, translitPunct} {
+		if t, ok := tbl[r]; ok {
+			return t
+		}
+		if t, ok := tbl[lower]; ok {
+			return t
+		}
+	}
+	if unicode.Is(unicode.Nd, r) {
+		// Digits are always in sequences of 10, starting at 0.
+		n := 0
+		for unicode.Is(unicode.Nd, r-rune(n)-1) {
+			n++
+		}
+		return string(rune('0' + n%10))
+	}
+	if unicode.IsSpace(r) {
+		return " "
+	}
+
+	// Decompose, and try again for everything that's not a combining mark;
+	// this takes care of things like "é" → "e" and "ﬁ" → "fi".
+	d := norm.NFKD.String(string(r))
+	if d == string(r) {
+		return ""
+	}
+	var b strings.Builder
+	for _, dr := range d {
+		if unicode.Is(unicode.Mn, dr) {
+			continue
+		}
+		t := translitASCII(dr)
+		if unicode.IsUpper(dr) && len(t) > 1 && t[0] >= 'a' && t[0] <= 'z' {
+			t = strings.ToUpper(t[:1]) + t[1:]
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+// stripMarks decomposes s and removes all combining marks.
+func stripMarks(s string) string {
+	d := []rune(norm.NFD.String(s))
+	n := d[:0]
+	for _, r := range d {
+		if !unicode.Is(unicode.Mn, r) {
+			n = append(n, r)
+		}
+	}
+	return string(n)
+}
+
+func translitISO9(r rune) string {
+	lower := unicode.ToLower(r)
+	t, ok := translitCyrillicISO9[lower]
+	if !ok {
+		t, ok = translitCyrillicISO9[r]
+	}
+	if !ok {
+		return string(r)
+	}
+	if r != lower && t != "" {
+		f, s := utf8.DecodeRuneInString(t)
+		t = string(unicode.ToUpper(f)) + t[s:]
+	}
+	return t
+}
+
+// Revised Romanization of Korean, for a single syllable without taking
+// neighbouring syllables in to account.
+var (
+	hangulInitial = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s",
+		"ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulMedial = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa",
+		"wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	hangulFinal = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l",
+		"l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+func translitHangul(r rune) (string, bool) {
+	const (
+		sBase  = 0xac00
+		sCount = 11172
+		nCount = 588
+		tCount = 28
+	)
+	if r < sBase || r >= sBase+sCount {
+		return "", false
+	}
+	s := r - sBase
+	return hangulInitial[s/nCount] + hangulMedial[(s%nCount)/tCount] + hangulFinal[s%tCount], true
+}
+
+// translitKana gets the Hepburn romanisation of hiragana and katakana.
+func translitKana(r rune) (string, bool) {
+	if r >= 0x30a1 && r <= 0x30f6 { // Katakana → Hiragana
+		r -= 0x60
+	}
+	if r == 'ー' {
+		return "-", true
+	}
+	t, ok := kana[r]
+	return t, ok
+}
+
+// translitKanaCombine combines small kana with the previous part; e.g. "き" and
+// "ゃ" become "kya". It reports if anything was combined.
+func translitKanaCombine(parts []TranslitPart, r rune, k string) bool {
+	if len(parts) == 0 {
+		return false
+	}
+	var (
+		prev = &parts[len(parts)-1]
+		hira = r
+	)
+	if hira >= 0x30a1 && hira <= 0x30f6 {
+		hira -= 0x60
+	}
+	switch hira {
+	case 'ゃ', 'ゅ', 'ょ':
+		if !strings.HasSuffix(prev.Out, "i") || len(prev.Out) < 2 {
+			return false
+		}
+		o := strings.TrimSuffix(prev.Out, "i")
+		if strings.HasSuffix(o, "sh") || strings.HasSuffix(o, "ch") || strings.HasSuffix(o, "j") {
+			k = k[1:]
+		}
+		prev.Out = o + k
+	case 'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ':
+		if len(prev.Out) < 2 {
+			return false
+		}
+		prev.Out = prev.Out[:len(prev.Out)-1] + k
+	case 'ー':
+		if prev.Out == "" {
+			return false
+		}
+		prev.Out += prev.Out[len(prev.Out)-1:]
+	default:
+		return false
+	}
+	prev.In += string(r)
+	return true
+}
+
+var kana = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "wi", 'ゑ': "we", 'を': "o", 'ん': "n",
+	'ゔ': "vu", 'ゕ': "ka", 'ゖ': "ke",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa", 'っ': "",
+	// Katakana without a hiragana equivalent at -0x60.
+	'ヷ' - 0x60: "va", 'ヸ' - 0x60: "vi", 'ヹ' - 0x60: "ve", 'ヺ' - 0x60: "vo",
+}
+
+// Latin letters that don't decompose.
+var translitLatin = map[rune]string{
+	'ß': "ss", 'ẞ': "SS", 'æ': "ae", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l",
+	'þ': "th", 'œ': "oe", 'ı': "i", 'ŋ': "ng", 'ħ': "h", 'ŧ': "t", 'ĸ': "q",
+	'ƒ': "f", 'ɛ': "e", 'ɔ': "o", 'ə': "e", 'ʒ': "zh", 'ƿ': "w", 'ȝ': "y",
+	'ĳ': "ij", 'ŀ': "l", 'ŉ': "n", 'ƀ': "b", 'ƈ': "c", 'ɖ': "d", 'ɗ': "d",
+	'ƙ': "k", 'ɲ': "n", 'ɓ': "b", 'ʋ': "v", 'ƴ': "y",
+}
+
+// Punctuation and symbols that don't decompose to ASCII.
+var translitPunct = map[rune]string{
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", '‹': "<", '›': ">",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`, '″': `"`, '«': "<<", '»': ">>",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'•': "*", '·': "*", '×': "x", '÷': "/", '¡': "!", '¿': "?", '§': "S",
+	'¶': "P", '©': "(c)", '®': "(r)", '°': "deg", '±': "+-", '€': "EUR",
+	'£': "GBP", '¥': "JPY", '¢': "c", '₹': "INR", '₽': "RUB", '₩': "KRW",
+	'。': ".", '、': ",", '，': ",", '「': `"`, '」': `"`, '『': `"`, '』': `"`,
+	'【': "[", '】': "]", '〜': "~", '・': " ", '،': ",", '؛': ";", '؟': "?",
+	'۔': ".", '⁄': "/", '∕': "/",
+}
+
+// Cyrillic; this is a simplified version of BGN/PCGN that only uses ASCII.
+var translitCyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+}
+
+// Cyrillic with ISO 9:1995.
+var translitCyrillicISO9 = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë",
+	'ж': "ž", 'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "h", 'ц': "c", 'ч': "č", 'ш': "š", 'щ': "ŝ", 'ъ': "ʺ",
+	'ы': "y", 'ь': "ʹ", 'э': "è", 'ю': "û", 'я': "â",
+	'є': "ê", 'і': "ì", 'ї': "ï", 'ґ': "g̀", 'ў': "ŭ", 'ђ': "đ", 'ј': "ǰ",
+	'љ': "l̂", 'њ': "n̂", 'ћ': "ć", 'џ': "d̂", 'ѓ': "ǵ", 'ќ': "ḱ", 'ѕ': "ẑ",
+	'ѣ': "ě", 'ѳ': "f̀", 'ѵ': "ỳ", 'ӏ': "‡",
+}
+
+// Greek; this is based on ELOT 743, without diacritics.
+var translitGreek = map[rune]string{
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Arabic (including Persian and Urdu letters), without vowel marks.
+var translitArabic = map[rune]string{
+	'ا': "a", 'أ': "a", 'إ': "i", 'آ': "a", 'ٱ': "a", 'ب': "b", 'ت': "t",
+	'ث': "th", 'ج': "j", 'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r",
+	'ز': "z", 'س': "s", 'ش': "sh", 'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z",
+	'ع': "'", 'غ': "gh", 'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l", 'م': "m",
+	'ن': "n", 'ه': "h", 'و': "w", 'ي': "y", 'ى': "a", 'ة': "h", 'ء': "'",
+	'ؤ': "'", 'ئ': "'", 'پ': "p", 'چ': "ch", 'ژ': "zh", 'گ': "g", 'ک': "k",
+	'ی': "y", 'ٹ': "t", 'ڈ': "d", 'ڑ': "r", 'ں': "n", 'ھ': "h", 'ے': "e",
+	'ہ': "h",
+}
+
+// Hebrew, without vowel points.
+var translitHebrew = map[rune]string{
+	'א': "", 'ב': "b", 'ג': "g", 'ד': "d", 'ה': "h", 'ו': "v", 'ז': "z",
+	'ח': "kh", 'ט': "t", 'י': "y", 'כ': "k", 'ך': "k", 'ל': "l", 'מ': "m",
+	'ם': "m", 'נ': "n", 'ן': "n", 'ס': "s", 'ע': "", 'פ': "p", 'ף': "f",
+	'צ': "ts", 'ץ': "ts", 'ק': "k", 'ר': "r", 'ש': "sh", 'ת': "t",
+}
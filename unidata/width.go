@@ -0,0 +1,127 @@
+package unidata
+
+import (
+	"strings"
+	"sync"
+
+	"zgo.at/runewidth"
+)
+
+// WidthModel is a model for the number of cells codepoints display as in a
+// terminal.
+type WidthModel uint8
+
+func (m WidthModel) String() string { return WidthModels[m] }
+
+// Width models.
+const (
+	// Wide and Fullwidth are 2 cells, combining and other zero-width
+	// characters 0, and everything else 1. This is what most modern terminals
+	// do.
+	WidthModelUnicode = WidthModel(iota)
+
+	// Like WidthModelUnicode, but Ambiguous characters are 2 cells, as is
+	// common in CJK locales.
+	WidthModelAmbiguousWide
+
+	// What glibc's wcwidth() returns: like WidthModelUnicode, but control
+	// characters and unassigned codepoints are -1, the soft hyphen is 1, and
+	// Hangul Jamo medial vowels and final consonants are 0.
+	WidthModelGlibc
+
+	// Like WidthModelUnicode, but emojis with emoji presentation are 1 cell,
+	// as in terminals that use width tables from before Unicode 9.
+	WidthModelEmojiNarrow
+)
+
+// WidthModels is a list of all width models.
+var WidthModels = map[WidthModel]string{
+	WidthModelUnicode:       "unicode",
+	WidthModelAmbiguousWide: "ambiguous-wide",
+	WidthModelGlibc:         "glibc-wcwidth",
+	WidthModelEmojiNarrow:   "emoji-narrow",
+}
+
+// FindWidthModel finds a width model by name.
+func FindWidthModel(name string) (WidthModel, bool) {
+	var (
+		match = matchName(name)
+		found []WidthModel
+	)
+	for k, m := range WidthModels {
+		if matchName(m) == match {
+			return k, true
+		}
+		if strings.HasPrefix(matchName(m), match) {
+			found = append(found, k)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], true
+	}
+	return 0, false
+}
+
+var (
+	narrowCond = &runewidth.Condition{EastAsianWidth: false, StrictEmojiNeutral: true}
+	wideCond   = &runewidth.Condition{EastAsianWidth: true, StrictEmojiNeutral: true}
+)
+
+// CellsWith gets the number of cells this codepoint will display as with the
+// given width model; 0, 1, or 2, or -1 for non-printable characters with
+// WidthModelGlibc.
+//
+// Unlike Cells(), this doesn't depend on the current locale.
+func (c Codepoint) CellsWith(m WidthModel) int {
+	switch m {
+	case WidthModelAmbiguousWide:
+		return wideCond.RuneWidth(c.Codepoint)
+	case WidthModelGlibc:
+		return c.wcwidth()
+	case WidthModelEmojiNarrow:
+		if c.width == WidthWide && isEmojiPresentation(c.Codepoint) {
+			return 1
+		}
+	}
+	return narrowCond.RuneWidth(c.Codepoint)
+}
+
+// This mirrors localedata/unicode-gen/utf8_gen.py in glibc.
+func (c Codepoint) wcwidth() int {
+	switch r := c.Codepoint; {
+	case r == 0:
+		return 0
+	case c.in(CatUnknown, CatUnassigned, CatControl, CatSurrogate):
+		return -1
+	case r == 0x00ad: // SOFT HYPHEN
+		return 1
+	case r == 0x200b, r >= 0x1160 && r <= 0x11ff, r >= 0xd7b0 && r <= 0xd7ff:
+		return 0
+	case c.in(CatNonspacingMark, CatEnclosingMark, CatFormat):
+		return 0
+	case c.width == WidthWide || c.width == WidthFullWidth:
+		return 2
+	}
+	return 1
+}
+
+var (
+	emojiPresentation     map[rune]struct{}
+	emojiPresentationOnce sync.Once
+)
+
+// Emojis with a single codepoint and without a variation selector have emoji
+// presentation by default. The Enclosed Ideographic Supplement was already
+// wide before Unicode 9.
+func isEmojiPresentation(r rune) bool {
+	emojiPresentationOnce.Do(func() {
+		emojiPresentation = make(map[rune]struct{})
+		for _, e := range Emojis {
+			if len(e.Codepoints) == 1 && (e.Codepoints[0] < 0x1f200 || e.Codepoints[0] > 0x1f2ff) {
+				emojiPresentation[e.Codepoints[0]] = struct{}{}
+			}
+		}
+	})
+	_, ok := emojiPresentation[r]
+	return ok
+}
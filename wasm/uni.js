@@ -0,0 +1,47 @@
+// Load uni as WebAssembly and get the JavaScript API; this needs wasm_exec.js
+// from the same Go version as used to build main.wasm (wasm/make copies it).
+//
+//   const uni = await loadUni('main.wasm')
+//   uni.identify('€a')                          // Characters in a string.
+//   uni.search('euro sign', {or: true})         // Search names.
+//   uni.print('U+2042..U+2050', {sort: 'name'}) // Same query as "uni print".
+//   uni.emoji('thumbs up', {tone: 'dark'})      // Same query as "uni emoji".
+//   uni.lookup(0x20ac)                          // Or 'U+20AC'; null if unassigned.
+//   uni.version, uni.unicode                    // uni and Unicode version.
+//
+// The functions return the same objects as "-as json-typed". The options are
+// the same as the flags without the "-":
+//
+//   format    Columns to return, as -format; the default is "all".
+//   raw       Don't replace control characters etc. for display.
+//   or        Use OR instead of AND for search and emoji.
+//   sort      cpoint (default), name, or name-collated.
+//   tone      Skin tones for emoji.
+//   gender    Genders for emoji; the default is "person".
+//
+// No matches is an empty array, and errors are thrown as an Error.
+//
+// The wasm can be given as a URL or as an ArrayBuffer.
+async function loadUni(wasm) {
+	const go = new Go()
+	const {instance} = typeof wasm === 'string'
+		? await WebAssembly.instantiateStreaming(fetch(wasm), go.importObject)
+		: await WebAssembly.instantiate(wasm, go.importObject)
+	go.argv = ['uni']
+	go.run(instance)  // Returns once uni exits, which is never.
+
+	const api = {version: globalThis.uni.version, unicode: globalThis.uni.unicode}
+	for (const name of ['identify', 'search', 'print', 'emoji', 'lookup']) {
+		const fn = globalThis.uni[name]
+		api[name] = (...args) => {
+			const r = fn(...args)
+			if (r instanceof Error)
+				throw r
+			return JSON.parse(r)
+		}
+	}
+	return api
+}
+
+if (typeof module !== 'undefined')
+	module.exports = loadUni
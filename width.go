@@ -0,0 +1,123 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+	"zgo.at/termtext"
+	"zgo.at/uni/v2/unidata"
+)
+
+// The width model to use for %(cells), alignment, and tables; set from the
+// -width-model flag.
+var widthModel unidata.WidthModel
+
+// textWidth gets the display width of s with the current width model and the
+// width overrides from "termwidth -save".
+func textWidth(s string) int { return modelWidth(s, widthOverrides) }
+
+// modelWidth gets the display width of s with the current width model, using
+// the widths in overrides for characters and sequences in there.
+//
+// Sequences of more than one codepoint use the width of the first codepoint
+// that's not zero-width, except that U+FE0F (emoji presentation) makes the
+// sequence 2 cells and U+FE0E (text presentation) makes it 1 cell.
+func modelWidth(s string, overrides map[string]int) int {
+	var (
+		g   = uniseg.NewGraphemes(s)
+		w   int
+		esc bool
+	)
+	for g.Next() {
+		if ow, ok := overrides[g.Str()]; ok {
+			w += ow
+			continue
+		}
+
+		runes := g.Runes()
+		if len(runes) > 1 {
+			if vs := variationWidth(runes); vs > 0 {
+				w += vs
+				continue
+			}
+			for _, r := range runes {
+				if rw := runeCells(r); rw > 0 {
+					w += rw
+					break
+				}
+			}
+			continue
+		}
+
+		switch r := runes[0]; {
+		case r == '\t':
+			w += termtext.TabWidth - w%termtext.TabWidth
+		case r == '\x1b':
+			esc = true
+		case esc:
+			if r == 'm' {
+				esc = false
+			}
+		default:
+			w += runeCells(r)
+		}
+	}
+	return w
+}
+
+// variationWidth gets the width of a sequence from a variation selector, or 0 if
+// there isn't one.
+func variationWidth(runes []rune) int {
+	for _, r := range runes[1:] {
+		switch r {
+		case 0xfe0f:
+			if widthModel == unidata.WidthModelEmojiNarrow {
+				return 1
+			}
+			return 2
+		case 0xfe0e:
+			return 1
+		}
+	}
+	return 0
+}
+
+func runeCells(r rune) int {
+	if r >= 0x20 && r < 0x7f {
+		return 1
+	}
+	info, _ := unidata.Find(r)
+	return max(info.CellsWith(widthModel), 0)
+}
+
+// leftAlign pads s with spaces on the right to n cells.
+func leftAlign(s string, n int) string {
+	if w := textWidth(s); w < n {
+		return s + strings.Repeat(" ", n-w)
+	}
+	return s
+}
+
+// rightAlign pads s with spaces on the left to n cells.
+func rightAlign(s string, n int) string {
+	if w := textWidth(s); w < n {
+		return strings.Repeat(" ", n-w) + s
+	}
+	return s
+}
+
+// elide s to n cells, adding "…" if it was shortened.
+func elide(s string, n int) string {
+	var (
+		g = uniseg.NewGraphemes(s)
+		w int
+	)
+	for g.Next() {
+		w += textWidth(g.Str())
+		if w > n {
+			start, _ := g.Positions()
+			return s[:start] + "…"
+		}
+	}
+	return s
+}